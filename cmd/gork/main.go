@@ -4,6 +4,7 @@ import (
 	"bufio"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 
 	"github.com/wakatara/gork/engine"
@@ -14,9 +15,38 @@ import (
 var version = "dev"
 
 func main() {
+	// Display settings come from the config file, then the flags
+	cfg, err := ui.LoadConfig()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+	}
+
+	// --retro only sets defaults, so the explicit flags win whatever the order
+	for _, arg := range os.Args[1:] {
+		if arg == "--retro" {
+			cfg.Retro()
+		}
+	}
+
 	// Handle flags
-	if len(os.Args) > 1 {
-		switch os.Args[1] {
+	debug := false
+	continueAfterWin := false
+	for _, arg := range os.Args[1:] {
+		if value, ok := strings.CutPrefix(arg, "--theme="); ok {
+			cfg.Theme = value
+			continue
+		}
+		if value, ok := strings.CutPrefix(arg, "--typing-speed="); ok {
+			speed, err := strconv.Atoi(value)
+			if err != nil || speed <= 0 {
+				fmt.Fprintf(os.Stderr, "Invalid typing speed %q\n", value)
+				os.Exit(1)
+			}
+			cfg.TypingSpeed = speed
+			continue
+		}
+
+		switch arg {
 		case "--version", "-version", "-v":
 			fmt.Printf("gork version %s\n", version)
 			fmt.Println("ZORK I: The Great Underground Empire")
@@ -29,19 +59,52 @@ func main() {
 			fmt.Println("  gork              Start the game")
 			fmt.Println("  gork --version    Show version information")
 			fmt.Println("  gork --help       Show this help message")
+			fmt.Println("  gork --debug      Check world invariants after every command")
+			fmt.Println("  gork --continue-after-win")
+			fmt.Println("                    Keep exploring after finishing the game")
+			fmt.Println()
+			fmt.Println("Display:")
+			fmt.Println("  --theme=NAME      amber, green, white, a color number (0-255) or #rrggbb")
+			fmt.Println("  --typing          Print text character by character")
+			fmt.Println("  --no-typing       Print text all at once")
+			fmt.Println("  --typing-speed=MS Delay between characters when typing")
+			fmt.Println("  --no-title        Skip the title screen")
+			fmt.Println("  --retro           Slow typing for that 1980s modem feel")
+			fmt.Println()
+			fmt.Println("The same settings can be kept in config.json in the gork config")
+			fmt.Println("directory, e.g. {\"theme\": \"green\", \"typing_effect\": true}")
 			fmt.Println()
 			fmt.Println("In-game commands:")
 			fmt.Println("  Type 'help' in the game for available commands")
 			fmt.Println("  Type 'quit' to exit the game")
 			return
+		case "--debug":
+			debug = true
+		case "--continue-after-win":
+			continueAfterWin = true
+		case "--typing":
+			cfg.TypingEffect = true
+		case "--no-typing":
+			cfg.TypingEffect = false
+		case "--no-title":
+			cfg.ShowTitle = false
 		}
 	}
 
+	if err := cfg.Apply(); err != nil {
+		fmt.Fprintf(os.Stderr, "Invalid theme: %v\n", err)
+		os.Exit(1)
+	}
+
 	// Display title
-	ui.PrintTitle()
+	if ui.ShowTitle {
+		ui.PrintTitle()
+	}
 
 	// Create new game with refactored types
 	game := engine.NewGameV2(version)
+	game.Debug = debug
+	game.ContinueAfterWin = continueAfterWin
 
 	// Display initial message
 	ui.PrintSlow(game.GetInitialMessage())
@@ -66,6 +129,13 @@ func main() {
 			continue
 		}
 
+		// Switch the color theme without spending a move
+		if fields := strings.Fields(input); strings.EqualFold(fields[0], "theme") {
+			ui.PrintSlow(ui.ThemeCommand(strings.Join(fields[1:], " ")))
+			fmt.Println()
+			continue
+		}
+
 		// Process command
 		output := game.Process(input)
 
@@ -0,0 +1,65 @@
+package engine
+
+// handleActorCommand runs a command addressed to another character, such as
+// "troll, give me the axe". In ZIL the PARSER makes the actor the WINNER and
+// the actor's action routine gets the first chance to handle the command.
+func (g *GameV2) handleActorCommand(cmd *Command) string {
+	npc := g.findNPC(cmd.Actor)
+	if npc == nil {
+		if item := g.findItem(cmd.Actor); item != nil {
+			return "You can't talk to the " + item.Name + "!"
+		}
+		return "You can't see any " + cmd.Actor + " here!"
+	}
+
+	if !npc.Flags.IsAlive {
+		return "The " + npc.Name + " is dead and cannot respond."
+	}
+
+	if npc.Action != nil {
+		if result := npc.Action(g, npc, cmd); result != "" {
+			return result
+		}
+	}
+
+	return "The " + npc.Name + " pays no attention to you."
+}
+
+// trollAction handles commands addressed to the troll (TROLL-FCN in ZIL)
+func trollAction(g *GameV2, npc *NPC, cmd *Command) string {
+	switch cmd.Verb {
+	case "give":
+		if cmd.IndirectObject == "me" && npc.Weapon != "" && cmd.DirectObject == npc.Weapon {
+			return "The troll spits in your face, grunting \"Better luck next time\" in a rather barbarous accent."
+		}
+		return "The troll, who is not overly generous, ignores you."
+	case "walk":
+		return "The troll stays exactly where he is, blocking the passages."
+	}
+	return "The troll isn't much of a conversationalist."
+}
+
+// thiefAction handles commands addressed to the thief (ROBBER-FUNCTION in ZIL)
+func thiefAction(g *GameV2, npc *NPC, cmd *Command) string {
+	if cmd.Verb == "give" && cmd.IndirectObject == "me" {
+		for _, id := range npc.Inventory {
+			item := g.Items[id]
+			if item != nil && (item.ID == cmd.DirectObject || item.HasAlias(cmd.DirectObject)) {
+				return "The thief laughs and tucks the " + item.Name + " deeper into his bag."
+			}
+		}
+	}
+	return "The thief is a strong, silent type."
+}
+
+// cyclopsAction handles commands addressed to the cyclops (CYCLOPS-FCN in ZIL)
+func cyclopsAction(g *GameV2, npc *NPC, cmd *Command) string {
+	if g.Flags["cyclops-flag"] {
+		return "No use talking to him. He's fast asleep."
+	}
+	// "cyclops, odysseus" works just like saying the name yourself
+	if cmd.Verb == "ulysses" {
+		return g.handleOdysseus()
+	}
+	return "The cyclops prefers eating to making conversation."
+}
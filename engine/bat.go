@@ -0,0 +1,70 @@
+package engine
+
+import "strings"
+
+// The vampire bat (BATS-ROOM, BAT-F, FLY-ME and BAT-DROPS in ZIL)
+//
+// The bat lives in his own room. Anyone who walks in without garlic is
+// grabbed and carried out to somewhere in the coal mine, where the bat drops
+// them and flies off back to his ceiling.
+
+// batDrops are the rooms the bat may leave the player in (BAT-DROPS in ZIL)
+var batDrops = []string{
+	"mine-1", "mine-2", "mine-3", "mine-4",
+	"ladder-top", "ladder-bottom", "squeeky-room", "mine-entrance",
+}
+
+// batDeparts is shown once the bat has dropped the player
+const batDeparts = "Having dropped you, the bat flies off into the darkness."
+
+// garlicNearby reports whether the player has garlic with them or in the
+// room, which keeps the bat away
+func (g *GameV2) garlicNearby() bool {
+	loc := g.parentOf("garlic")
+	return loc == "inventory" || loc == g.Location
+}
+
+// batGrabs runs when the player walks into the bat's room (BATS-ROOM M-ENTER
+// in ZIL). It returns "" when the bat leaves them alone.
+func (g *GameV2) batGrabs() string {
+	bat := g.NPCs["bat"]
+	if bat == nil || !bat.Flags.IsAlive || g.Dead || g.garlicNearby() {
+		return ""
+	}
+	return g.handleLook() + "\n\n" + g.flyMe()
+}
+
+// batAttacked handles attacking the bat (BAT-F in ZIL)
+func (g *GameV2) batAttacked() string {
+	if g.garlicNearby() {
+		return "You can't reach him; he's on the ceiling."
+	}
+	return g.flyMe()
+}
+
+// flyMe carries the player off to one of the bat drops, picked with the
+// game's RNG, and the bat flies off home again (FLY-ME in ZIL)
+func (g *GameV2) flyMe() string {
+	var result strings.Builder
+	result.WriteString(fweep(4))
+	result.WriteString("The bat grabs you by the scruff of your neck and lifts you away....\n\n")
+
+	result.WriteString(g.goTo(batDrops[g.randomInt(len(batDrops))]))
+	g.Darkness.InDarkness = g.inDarkness()
+	result.WriteString("\n\n" + batDeparts)
+	return result.String()
+}
+
+// fweep is the bat's cry, repeated n-1 times (FWEEP in ZIL)
+func fweep(n int) string {
+	return strings.Repeat("    Fweep!\n", n-1) + "\n"
+}
+
+// batDescription describes the bat, who keeps his distance from garlic
+// (BAT-D in ZIL)
+func (g *GameV2) batDescription() string {
+	if g.garlicNearby() {
+		return "In the corner of the room on the ceiling is a large vampire bat who is obviously deranged and holding his nose."
+	}
+	return "A large vampire bat, hanging from the ceiling, swoops down at you!"
+}
@@ -0,0 +1,72 @@
+package engine
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+// enterBatRoom walks a player with a lit lamp into the bat's room from the
+// squeaky room and returns where they ended up
+func enterBatRoom(seed int64, withGarlic bool) (*GameV2, string) {
+	g := NewGameV2("test")
+	g.rand = rand.New(rand.NewSource(seed))
+	g.Location = "squeeky-room"
+	g.moveItem("lamp", "inventory")
+	g.Items["lamp"].Flags.IsLit = true
+	if withGarlic {
+		g.moveItem("garlic", "inventory")
+	}
+	return g, g.Process("north")
+}
+
+func TestBatCarriesPlayerToMine(t *testing.T) {
+	g, result := enterBatRoom(1, false)
+
+	if !strings.Contains(result, "Fweep!") {
+		t.Errorf("Expected the bat to swoop, got: %s", result)
+	}
+	if !containsString(batDrops, g.Location) {
+		t.Errorf("Location = %q, want one of the bat drops %v", g.Location, batDrops)
+	}
+	if !strings.HasSuffix(result, batDeparts) {
+		t.Errorf("Expected the bat to fly off after dropping the player, got: %s", result)
+	}
+	if g.NPCs["bat"].Location != "bat-room" {
+		t.Errorf("bat Location = %q, want him back in bat-room", g.NPCs["bat"].Location)
+	}
+
+	// The same seed drops the player in the same place
+	again, _ := enterBatRoom(1, false)
+	if again.Location != g.Location {
+		t.Errorf("Same seed dropped the player in %q and then %q", g.Location, again.Location)
+	}
+}
+
+func TestGarlicKeepsBatAway(t *testing.T) {
+	g, result := enterBatRoom(1, true)
+
+	if g.Location != "bat-room" {
+		t.Errorf("Location = %q, want bat-room", g.Location)
+	}
+	if !strings.Contains(result, "holding his nose") {
+		t.Errorf("Expected the bat to keep his distance, got: %s", result)
+	}
+	if result := g.Process("attack bat"); !strings.Contains(result, "he's on the ceiling") {
+		t.Errorf("Expected the bat to be out of reach, got: %s", result)
+	}
+}
+
+func TestAttackingBatWithoutGarlic(t *testing.T) {
+	g := NewGameV2("test")
+	g.rand = rand.New(rand.NewSource(2))
+	g.Location = "bat-room"
+
+	result := g.Process("attack bat")
+	if !strings.Contains(result, "lifts you away") {
+		t.Errorf("Expected the bat to carry the player off, got: %s", result)
+	}
+	if !containsString(batDrops, g.Location) {
+		t.Errorf("Location = %q, want one of the bat drops", g.Location)
+	}
+}
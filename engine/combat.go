@@ -14,8 +14,8 @@ const (
 	CombatSeriousWound
 	CombatStagger
 	CombatLoseWeapon
-	CombatHesitate    // For unconscious victims (not used in basic implementation)
-	CombatSittingDuck // For unconscious victims (not used in basic implementation)
+	CombatHesitate    // Villain spares an unconscious player this round
+	CombatSittingDuck // Unconscious victim is finished off
 )
 
 // Combat constants (from ZIL 1actions.zil:3323-3325)
@@ -89,6 +89,7 @@ var def3C = CombatResultTable{
 type VillainData struct {
 	BestWeapon    string              // Weapon that weakens this NPC
 	BestAdvantage int                 // Strength reduction when player has best weapon
+	FirstStrike   int                 // Percent chance per turn of starting a fight (F-FIRST? in ZIL)
 	MeleeMessages map[int][]string    // Messages indexed by outcome type
 }
 
@@ -96,16 +97,19 @@ var villainData = map[string]VillainData{
 	"troll": {
 		BestWeapon:    "sword",
 		BestAdvantage: 1,
+		FirstStrike:   33,
 		MeleeMessages: trollMelee,
 	},
 	"thief": {
 		BestWeapon:    "knife",
 		BestAdvantage: 1,
+		FirstStrike:   20,
 		MeleeMessages: thiefMelee,
 	},
 	"cyclops": {
 		BestWeapon:    "", // No weakness
 		BestAdvantage: 0,
+		FirstStrike:   0, // Only fights when attacked
 		MeleeMessages: cyclopsMelee,
 	},
 }
@@ -113,12 +117,8 @@ var villainData = map[string]VillainData{
 // fightStrength calculates player's combat strength
 // From ZIL FIGHT-STRENGTH routine (1actions.zil:3375-3381)
 func (g *GameV2) fightStrength() int {
-	// Base strength from score
-	// ZIL: STRENGTH-MIN + (SCORE / (SCORE-MAX / (STRENGTH-MAX - STRENGTH-MIN)))
-	baseStrength := StrengthMin + (g.Score / (ScoreMax / (StrengthMax - StrengthMin)))
-
 	// Add P?STRENGTH modifier (normally 0, reduced by wounds)
-	finalStrength := baseStrength + g.Player.StrengthModifier
+	finalStrength := g.baseFightStrength() + g.Player.StrengthModifier
 
 	if finalStrength < 1 {
 		return 1
@@ -126,6 +126,13 @@ func (g *GameV2) fightStrength() int {
 	return finalStrength
 }
 
+// baseFightStrength is the player's strength when unwounded (FIGHT-STRENGTH
+// called with ADJUST? false in ZIL)
+func (g *GameV2) baseFightStrength() int {
+	// ZIL: STRENGTH-MIN + (SCORE / (SCORE-MAX / (STRENGTH-MAX - STRENGTH-MIN)))
+	return StrengthMin + (g.Score / (ScoreMax / (StrengthMax - StrengthMin)))
+}
+
 // villainStrength calculates NPC's combat strength
 // From ZIL VILLAIN-STRENGTH routine (1actions.zil:3383-3397)
 func (g *GameV2) villainStrength(npc *NPC) int {
@@ -139,15 +146,12 @@ func (g *GameV2) villainStrength(npc *NPC) int {
 		g.Flags["thief-engrossed"] = false
 	}
 
-	// If player has NPC's best weapon, reduce NPC strength
+	// If player is fighting with NPC's best weapon, reduce NPC strength
 	if vData, ok := villainData[npc.ID]; ok && vData.BestWeapon != "" {
-		for _, itemID := range g.Player.Inventory {
-			if itemID == vData.BestWeapon {
-				strength -= vData.BestAdvantage
-				if strength < 1 {
-					strength = 1
-				}
-				break
+		if weapon := g.wieldedWeapon(); weapon != nil && weapon.ID == vData.BestWeapon {
+			strength -= vData.BestAdvantage
+			if strength < 1 {
+				strength = 1
 			}
 		}
 	}
@@ -182,6 +186,17 @@ func selectResultTable(att, def int) CombatResultTable {
 // heroBlow performs player attack on NPC
 // From ZIL HERO-BLOW routine (1actions.zil:3476-3560)
 func (g *GameV2) heroBlow(npc *NPC, weapon *Item) (int, string) {
+	// A staggered player wastes this attack (ZIL lines 3479-3482)
+	if g.Player.Staggered {
+		g.Player.Staggered = false
+		return CombatMissed, "You are still recovering from that last blow, so your attack is ineffective."
+	}
+
+	// An unconscious villain is a sitting duck
+	if npc.Flags.IsUnconscious {
+		return CombatSittingDuck, strings.ReplaceAll(heroMelee[CombatSittingDuck][0], "{npc}", npc.Name)
+	}
+
 	att := g.fightStrength()
 	def := g.villainStrength(npc)
 
@@ -220,15 +235,13 @@ func (g *GameV2) heroBlow(npc *NPC, weapon *Item) (int, string) {
 // From ZIL HERO-BLOW outcome handling (1actions.zil:3452-3474)
 func (g *GameV2) applyHeroOutcome(npc *NPC, outcome int) {
 	switch outcome {
-	case CombatKilled:
+	case CombatKilled, CombatSittingDuck:
 		npc.Strength = 0
 		npc.Flags.IsAlive = false
+		npc.Flags.IsUnconscious = false
 
 	case CombatUnconscious:
-		// In full ZIL, NPCs can wake up from unconsciousness
-		// For simplicity, treat as death
-		npc.Strength = 0
-		npc.Flags.IsAlive = false
+		g.knockOut(npc)
 
 	case CombatLightWound:
 		npc.Strength -= 1
@@ -245,28 +258,27 @@ func (g *GameV2) applyHeroOutcome(npc *NPC, outcome int) {
 		}
 
 	case CombatStagger:
-		// NPC skips next turn
-		g.Flags[npc.ID+"-staggered"] = true
+		// NPC skips its next attack
+		npc.Flags.IsStaggered = true
 
 	case CombatLoseWeapon:
 		// NPC drops weapon
 		weapon := g.findNPCWeapon(npc)
 		if weapon != nil {
-			weapon.Location = npc.Location
-			if room := g.Rooms[npc.Location]; room != nil {
-				room.AddItem(weapon.ID)
-			}
-			npc.Inventory = removeFromSlice(npc.Inventory, weapon.ID)
+			g.moveItem(weapon.ID, npc.Location)
 		}
 	}
 }
 
-// villainBlow performs NPC counter-attack on player
+// villainBlow performs NPC counter-attack on player. When the player is
+// out cold, the villain either hesitates or finishes them off.
 // From ZIL VILLAIN-BLOW routine (1actions.zil:3413-3474)
-func (g *GameV2) villainBlow(npc *NPC) (int, string) {
+func (g *GameV2) villainBlow(npc *NPC, playerOut bool) (int, string) {
+	g.Player.Staggered = false
+
 	// If NPC is staggered, skip turn (ZIL lines 3418-3422)
-	if g.Flags[npc.ID+"-staggered"] {
-		g.Flags[npc.ID+"-staggered"] = false
+	if npc.Flags.IsStaggered {
+		npc.Flags.IsStaggered = false
 		return CombatMissed, fmt.Sprintf("The %s slowly regains his feet.", npc.Name)
 	}
 
@@ -280,9 +292,17 @@ func (g *GameV2) villainBlow(npc *NPC) (int, string) {
 	outcomeIndex := g.rand.Intn(9)
 	outcome := table[outcomeIndex]
 
+	if playerOut {
+		if outcome == CombatStagger {
+			outcome = CombatHesitate
+		} else {
+			outcome = CombatSittingDuck
+		}
+	}
+
 	// 25% chance to convert STAGGER to LOSE-WEAPON
 	if outcome == CombatStagger && g.rand.Intn(100) < 25 {
-		playerWeapon := g.findPlayerWeapon()
+		playerWeapon := g.wieldedWeapon()
 		if playerWeapon != nil {
 			outcome = CombatLoseWeapon
 		}
@@ -302,7 +322,7 @@ func (g *GameV2) villainBlow(npc *NPC) (int, string) {
 	message := messages[g.rand.Intn(len(messages))]
 
 	// Replace placeholders (note: {weapon} refers to PLAYER's weapon)
-	playerWeapon := g.findPlayerWeapon()
+	playerWeapon := g.wieldedWeapon()
 	if playerWeapon != nil {
 		message = strings.ReplaceAll(message, "{weapon}", playerWeapon.Name)
 	}
@@ -314,36 +334,105 @@ func (g *GameV2) villainBlow(npc *NPC) (int, string) {
 // From ZIL VILLAIN-BLOW outcome handling (1actions.zil:3452-3474)
 func (g *GameV2) applyVillainOutcome(outcome int) {
 	switch outcome {
-	case CombatKilled:
+	case CombatKilled, CombatSittingDuck:
 		g.Player.Health = 0
 
 	case CombatUnconscious:
-		g.Player.Health = 0 // Simplified: treat as death
+		// The villain gets free rounds (see fightBack)
 
 	case CombatLightWound:
-		g.Player.StrengthModifier -= 1
+		g.wound(1)
 
 	case CombatSeriousWound:
-		g.Player.StrengthModifier -= 2
+		g.wound(2)
 
 	case CombatStagger:
-		// Player is staggered (in ZIL, misses next turn)
-		// We'll just show it in the message, no mechanical effect needed
-		// since this is single combat, not turn-based with multiple NPCs
+		// Player's next attack is wasted
+		g.Player.Staggered = true
 
 	case CombatLoseWeapon:
 		// Player drops weapon
-		playerWeapon := g.findPlayerWeapon()
+		playerWeapon := g.wieldedWeapon()
 		if playerWeapon != nil {
-			playerWeapon.Location = g.Location
-			if room := g.Rooms[g.Location]; room != nil {
-				room.AddItem(playerWeapon.ID)
-			}
-			g.Player.Inventory = removeFromSlice(g.Player.Inventory, playerWeapon.ID)
+			g.moveItem(playerWeapon.ID, g.Location)
 		}
 	}
 }
 
+// fightBack runs a villain's counter-attack. A player who is knocked out
+// loses one to three more rounds, during which the villain may finish them
+// off. From ZIL DO-FIGHT (1actions.zil:3326-3352)
+func (g *GameV2) fightBack(npc *NPC) string {
+	var result strings.Builder
+
+	outcome, message := g.villainBlow(npc, false)
+	result.WriteString(message + "\n")
+	g.applyVillainOutcome(outcome)
+
+	if outcome == CombatUnconscious {
+		for rounds := g.randomInt(3) + 1; rounds > 0 && g.Player.Health > 0; rounds-- {
+			outcome, message = g.villainBlow(npc, true)
+			result.WriteString(message + "\n")
+			g.applyVillainOutcome(outcome)
+		}
+	}
+
+	// Check if player died
+	if g.Player.Health <= 0 {
+		result.WriteString("\n" + g.jigsUp("It appears that that last blow was too much for you. I'm afraid you are dead."))
+	}
+
+	return result.String()
+}
+
+// knockOut leaves a villain lying helpless (F-UNCONSCIOUS in ZIL). His
+// weapon falls to the floor; a knocked-out troll no longer guards the
+// passages.
+func (g *GameV2) knockOut(npc *NPC) {
+	npc.Flags.IsUnconscious = true
+	npc.Flags.IsStaggered = false
+	npc.WakeChance = 0
+
+	// NPC weapons are out of play while the NPC wields them
+	if weapon := g.Items[npc.Weapon]; weapon != nil && weapon.Location == "" {
+		g.moveItem(weapon.ID, npc.Location)
+	}
+	if npc.ID == "troll" {
+		g.Flags["troll-dead"] = true
+	}
+}
+
+// awaken brings a villain round and he picks his weapon back up if it is
+// still lying there (F-CONSCIOUS in ZIL)
+func (g *GameV2) awaken(npc *NPC) string {
+	npc.Flags.IsUnconscious = false
+	npc.WakeChance = 0
+
+	if weapon := g.Items[npc.Weapon]; weapon != nil && weapon.Location == npc.Location {
+		g.removeItem(weapon.ID)
+	}
+
+	switch npc.ID {
+	case "troll":
+		g.Flags["troll-dead"] = false
+		return "The troll stirs, quickly resuming a fighting stance."
+	case "thief":
+		return "The robber revives, briefly feigning continued unconsciousness, and, when he sees his moment, scrambles away from you."
+	}
+	return fmt.Sprintf("The %s regains consciousness.", npc.Name)
+}
+
+// unconsciousDescription describes a knocked-out villain in a room listing
+func unconsciousDescription(npc *NPC) string {
+	switch npc.ID {
+	case "troll":
+		return "An unconscious troll is sprawled on the floor. All passages out of the room are open."
+	case "thief":
+		return "There is a suspicious-looking individual lying unconscious on the ground."
+	}
+	return fmt.Sprintf("The %s is lying unconscious on the ground.", npc.Name)
+}
+
 // findPlayerWeapon finds the first weapon in player's inventory
 func (g *GameV2) findPlayerWeapon() *Item {
 	for _, itemID := range g.Player.Inventory {
@@ -355,8 +444,22 @@ func (g *GameV2) findPlayerWeapon() *Item {
 	return nil
 }
 
-// findNPCWeapon finds the first weapon in NPC's inventory
+// wieldedWeapon returns the weapon the player fights with: the one they
+// last attacked with if they still hold it, otherwise the first weapon they
+// are carrying
+func (g *GameV2) wieldedWeapon() *Item {
+	if weapon := g.Items[g.Player.Weapon]; weapon != nil && g.parentOf(weapon.ID) == "inventory" {
+		return weapon
+	}
+	return g.findPlayerWeapon()
+}
+
+// findNPCWeapon finds the weapon an NPC is wielding, or the first weapon in
+// its inventory
 func (g *GameV2) findNPCWeapon(npc *NPC) *Item {
+	if g.npcWieldsWeapon(npc) && npc.Weapon != "" {
+		return g.Items[npc.Weapon]
+	}
 	for _, itemID := range npc.Inventory {
 		item := g.Items[itemID]
 		if item != nil && item.Flags.IsWeapon {
@@ -366,6 +469,13 @@ func (g *GameV2) findNPCWeapon(npc *NPC) *Item {
 	return nil
 }
 
+// npcWieldsWeapon reports whether an NPC still has its own weapon in hand.
+// NPC weapons are out of play while wielded; NPCs without one fight bare-handed.
+func (g *GameV2) npcWieldsWeapon(npc *NPC) bool {
+	weapon := g.Items[npc.Weapon]
+	return weapon == nil || weapon.Location == ""
+}
+
 // Helper function to remove item from slice
 func removeFromSlice(slice []string, item string) []string {
 	result := make([]string, 0, len(slice))
@@ -48,6 +48,9 @@ var heroMelee = map[int][]string{
 		"The {npc}'s weapon is knocked to the floor, leaving him unarmed.",
 		"The {npc} is disarmed by a subtle feint past his guard.",
 	},
+	CombatSittingDuck: {
+		"The unconscious {npc} cannot defend himself: He dies.",
+	},
 }
 
 // Troll counter-attack messages (TROLL-MELEE table, 1actions.zil:3689-3729)
@@ -88,6 +91,13 @@ var trollMelee = map[int][]string{
 		"The troll swings, you parry, but the force of his blow knocks your {weapon} away.",
 		"The axe knocks your {weapon} out of your hand. It falls to the floor.",
 	},
+	CombatHesitate: {
+		"The troll hesitates, fingering his axe.",
+		"The troll scratches his head ruminatively: Might you be magically protected, he wonders?",
+	},
+	CombatSittingDuck: {
+		"Conquering his fears, the troll puts you to death.",
+	},
 }
 
 // Thief counter-attack messages (THIEF-MELEE table, 1actions.zil:3735-3788)
@@ -129,6 +139,15 @@ var thiefMelee = map[int][]string{
 		"The thief neatly flips your {weapon} out of your hands, and it drops to the floor.",
 		"You parry a low thrust, and your {weapon} slips out of your hand.",
 	},
+	CombatHesitate: {
+		"The thief, a man of superior breeding, pauses for a moment to consider the propriety of finishing you off.",
+		"The thief amuses himself by searching your pockets.",
+		"The thief entertains himself by rifling your pack.",
+	},
+	CombatSittingDuck: {
+		"The thief, forgetting his essentially genteel upbringing, cuts your throat.",
+		"The thief, a pragmatist, dispatches you as a threat to his livelihood.",
+	},
 }
 
 // Cyclops counter-attack messages (CYCLOPS-MELEE table, 1actions.zil:3654-3683)
@@ -159,4 +178,10 @@ var cyclopsMelee = map[int][]string{
 		"The Cyclops grabs your {weapon}, tastes it, and throws it to the ground in disgust.",
 		"The monster grabs you on the wrist, squeezes, and you drop your {weapon} in pain.",
 	},
+	CombatHesitate: {
+		"The Cyclops seems unable to decide whether to broil or stew his dinner.",
+	},
+	CombatSittingDuck: {
+		"The Cyclops, no sportsman, dispatches his unconscious victim.",
+	},
 }
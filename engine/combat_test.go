@@ -1,6 +1,7 @@
 package engine
 
 import (
+	"math/rand"
 	"strings"
 	"testing"
 )
@@ -187,6 +188,10 @@ func TestTrollCombat(t *testing.T) {
 	// Fight troll until one dies (max 50 rounds for safety)
 	maxRounds := 50
 	for i := 0; i < maxRounds; i++ {
+		// A disarmed player picks the sword back up
+		if g.findPlayerWeapon() == nil {
+			g.Process("take sword")
+		}
 		result := g.Process("attack troll")
 
 		// Check if combat ended - troll died
@@ -200,7 +205,7 @@ func TestTrollCombat(t *testing.T) {
 		}
 
 		// Check if combat ended - player died
-		if g.GameOver || g.Player.Health <= 0 {
+		if g.Deaths > 0 || g.GameOver {
 			t.Logf("Player died in %d rounds (this can happen in fair combat!)", i+1)
 			// Verify we got the death message
 			if strings.Contains(result, "game is over") || strings.Contains(result, "You have died") {
@@ -244,6 +249,10 @@ func TestThiefCombat(t *testing.T) {
 		g.Player.Inventory = append(g.Player.Inventory, "knife")
 	}
 
+	// Light the maze so only the thief can kill the player
+	g.moveItem("lamp", "inventory")
+	g.Items["lamp"].Flags.IsLit = true
+
 	// Thief should already be in maze-1
 	thief := g.NPCs["thief"]
 	if thief == nil {
@@ -280,7 +289,7 @@ func TestThiefCombat(t *testing.T) {
 			return
 		}
 
-		if g.Player.Health <= 0 {
+		if g.Deaths > 0 || g.GameOver {
 			t.Logf("Player died in %d rounds fighting thief (this is expected - thief strength 5 is VERY tough!)", i+1)
 			t.Logf("In ZIL, thief is much stronger than troll. A mid-level player can lose this fight.")
 			return
@@ -377,3 +386,166 @@ func TestScoreAffectsCombat(t *testing.T) {
 
 	t.Log("Verified: collecting treasures (increasing score) makes you stronger in combat!")
 }
+
+// TestUnconsciousTroll checks that a knocked-out troll opens the passages,
+// dies to a single blow, and otherwise comes round after a few turns
+func TestUnconsciousTroll(t *testing.T) {
+	g := NewGameV2("test")
+	g.rand = rand.New(rand.NewSource(1))
+	g.Location = "troll-room"
+	g.moveItem("sword", "inventory")
+	g.moveItem("lamp", "inventory")
+	g.Items["lamp"].Flags.IsLit = true
+	troll := g.NPCs["troll"]
+
+	g.applyHeroOutcome(troll, CombatUnconscious)
+	if !troll.Flags.IsAlive || !troll.Flags.IsUnconscious {
+		t.Fatalf("troll alive=%v unconscious=%v, want a living unconscious troll", troll.Flags.IsAlive, troll.Flags.IsUnconscious)
+	}
+	if !g.Flags["troll-dead"] {
+		t.Error("passages should be open while the troll is out")
+	}
+	if g.Items["axe"].Location != "troll-room" {
+		t.Errorf("axe Location = %q, want it dropped in the troll room", g.Items["axe"].Location)
+	}
+	if look := g.Process("look"); !strings.Contains(look, "An unconscious troll is sprawled on the floor") {
+		t.Errorf("look should describe the unconscious troll, got: %s", look)
+	}
+
+	// Waiting long enough wakes him up
+	woke := false
+	for i := 0; i < 10 && !woke; i++ {
+		woke = strings.Contains(g.Process("wait"), "The troll stirs")
+	}
+	if !woke || troll.Flags.IsUnconscious {
+		t.Fatal("troll should come round within 10 turns")
+	}
+	if g.Flags["troll-dead"] {
+		t.Error("passages should close again once the troll wakes")
+	}
+	if g.Items["axe"].Location != "" {
+		t.Error("troll should pick his axe back up")
+	}
+
+	// Knocked out again, he is a sitting duck
+	g.applyHeroOutcome(troll, CombatUnconscious)
+	result := g.Process("attack troll with sword")
+	if troll.Flags.IsAlive {
+		t.Fatalf("unconscious troll should die in one blow, got: %s", result)
+	}
+	if !strings.Contains(result, "cannot defend himself") {
+		t.Errorf("expected the sitting duck message, got: %s", result)
+	}
+}
+
+// TestStaggeredVillainSkipsAttack checks that a staggered NPC spends its
+// turn regaining its feet instead of attacking
+func TestStaggeredVillainSkipsAttack(t *testing.T) {
+	g := NewGameV2("test")
+	troll := g.NPCs["troll"]
+
+	g.applyHeroOutcome(troll, CombatStagger)
+	outcome, message := g.villainBlow(troll, false)
+	if outcome != CombatMissed || !strings.Contains(message, "slowly regains his feet") {
+		t.Errorf("villainBlow = (%d, %q), want the troll to regain his feet", outcome, message)
+	}
+	if troll.Flags.IsStaggered {
+		t.Error("stagger should only last one round")
+	}
+}
+
+// TestStaggeredPlayerLosesAttack checks that a staggered player's next
+// attack is wasted
+func TestStaggeredPlayerLosesAttack(t *testing.T) {
+	g := NewGameV2("test")
+	troll := g.NPCs["troll"]
+
+	g.applyVillainOutcome(CombatStagger)
+	outcome, message := g.heroBlow(troll, g.Items["sword"])
+	if outcome != CombatMissed || !strings.Contains(message, "still recovering") {
+		t.Errorf("heroBlow = (%d, %q), want a wasted attack", outcome, message)
+	}
+	if g.Player.Staggered {
+		t.Error("stagger should only last one round")
+	}
+}
+
+// TestKnockedOutPlayerLosesTurns checks that being knocked out is not death
+// in itself: the villain gets free rounds that either hesitate or kill
+func TestKnockedOutPlayerLosesTurns(t *testing.T) {
+	g := NewGameV2("test")
+	g.applyVillainOutcome(CombatUnconscious)
+	if g.Player.Health <= 0 {
+		t.Fatal("being knocked out should not kill the player outright")
+	}
+
+	troll := g.NPCs["troll"]
+	for seed := int64(1); seed <= 50; seed++ {
+		g.rand = rand.New(rand.NewSource(seed))
+		outcome, _ := g.villainBlow(troll, true)
+		if outcome != CombatHesitate && outcome != CombatSittingDuck {
+			t.Fatalf("seed %d: villainBlow on an unconscious player = %d, want hesitate or sitting duck", seed, outcome)
+		}
+	}
+}
+
+// TestBestWeaponOnlyCountsInHand checks that the troll is only weakened when
+// the sword is the weapon actually in use
+func TestBestWeaponOnlyCountsInHand(t *testing.T) {
+	g := NewGameV2("test")
+	troll := g.NPCs["troll"]
+	g.moveItem("knife", "inventory")
+	g.moveItem("sword", "inventory")
+
+	g.Player.Weapon = "knife"
+	if got := g.villainStrength(troll); got != 2 {
+		t.Errorf("fighting with the knife: villainStrength = %d, want 2", got)
+	}
+
+	g.Player.Weapon = "sword"
+	if got := g.villainStrength(troll); got != 1 {
+		t.Errorf("fighting with the sword: villainStrength = %d, want 1", got)
+	}
+}
+
+// TestAttackWithWeaponChoice checks that "attack X with Y" uses Y and
+// refuses unsuitable or missing weapons
+func TestAttackWithWeaponChoice(t *testing.T) {
+	g := NewGameV2("test")
+	g.Location = "troll-room"
+	g.moveItem("lamp", "inventory")
+	g.Items["lamp"].Flags.IsLit = true
+	g.moveItem("knife", "inventory")
+	g.moveItem("sword", "inventory")
+
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"attack troll with lamp", "Trying to attack the nasty troll with a brass lantern is suicidal."},
+		{"attack troll with hands", "Trying to attack a nasty troll with your bare hands is suicidal."},
+		{"attack troll with axe", "You can't see any axe here."},
+	}
+	for _, tt := range tests {
+		cmd, err := NewParser().Parse(tt.input)
+		if err != nil {
+			t.Fatalf("Parse(%q) error = %v", tt.input, err)
+		}
+		if got := g.handleAttack(cmd.DirectObject, cmd.IndirectObject); got != tt.want {
+			t.Errorf("%q = %q, want %q", tt.input, got, tt.want)
+		}
+	}
+
+	g.moveItem("axe", "troll-room")
+	if got := g.handleAttack("troll", "axe"); got != "You aren't even holding the bloody axe." {
+		t.Errorf("attack with the axe on the floor = %q", got)
+	}
+
+	result := g.handleAttack("troll", "knife")
+	if !strings.Contains(result, "with your nasty knife") || g.Player.Weapon != "knife" {
+		t.Errorf("attack with knife should fight with the knife, got: %s", result)
+	}
+	if w := g.wieldedWeapon(); w == nil || w.ID != "knife" {
+		t.Error("the knife should stay in hand for later rounds")
+	}
+}
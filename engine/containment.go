@@ -0,0 +1,239 @@
+package engine
+
+import "sort"
+
+// The containment tree (ZIL's object tree: LOC, FIRST?, NEXT?, MOVE, REMOVE)
+//
+// Item.Location is the authoritative parent of every item. It is one of:
+//   - a room ID
+//   - "inventory" for the player
+//   - an NPC ID
+//   - another item's ID (a container)
+//   - "" when the item is out of play
+//
+// Each parent also keeps an ordered list of its children so that "what is
+// in here?" is answered without scanning every item: Room.Contents,
+// Player.Inventory, NPC.Inventory, and g.itemContents for containers. Those
+// lists are views of Location and must only be changed through moveItem.
+
+// Location values that older saves may still hold; a restore maps them to
+// the current ones
+const (
+	legacyPlayerLocation = "player-inventory"
+	legacyThiefLocation  = "thief-inventory"
+	removedLocation      = "REMOVED"
+)
+
+// canonicalLocation maps legacy spellings of a parent to the one used now
+func canonicalLocation(loc string) string {
+	switch loc {
+	case legacyPlayerLocation:
+		return "inventory"
+	case legacyThiefLocation:
+		return "thief"
+	case removedLocation:
+		return ""
+	}
+	return loc
+}
+
+// childList returns a pointer to the ordered child list of a parent, or nil
+// if the parent cannot hold anything
+func (g *GameV2) childList(parent string) *[]string {
+	parent = canonicalLocation(parent)
+	if parent == "" || parent == "GLOBAL" {
+		return nil
+	}
+	if parent == "inventory" {
+		return &g.Player.Inventory
+	}
+	if room := g.Rooms[parent]; room != nil {
+		return &room.Contents
+	}
+	if npc := g.NPCs[parent]; npc != nil {
+		return &npc.Inventory
+	}
+	if g.Items[parent] != nil {
+		if g.itemContents == nil {
+			g.itemContents = make(map[string]*[]string)
+		}
+		list := g.itemContents[parent]
+		if list == nil {
+			list = &[]string{}
+			g.itemContents[parent] = list
+		}
+		return list
+	}
+	return nil
+}
+
+// moveItem moves an item, together with everything inside it, to a new
+// parent (MOVE in ZIL). An empty destination takes it out of play (REMOVE).
+// This is the only place the child lists are changed.
+func (g *GameV2) moveItem(itemID, dest string) {
+	item := g.Items[itemID]
+	if item == nil {
+		return
+	}
+	itemID = item.ID // Resolve second keys such as bag-of-coins
+	if container := g.Items[dest]; container != nil {
+		dest = container.ID
+	}
+
+	// Global objects are listed in several rooms; detach them from none
+	if item.Location != "GLOBAL" {
+		if list := g.childList(item.Location); list != nil {
+			*list = removeFromSlice(*list, itemID)
+		}
+	}
+
+	item.Location = canonicalLocation(dest)
+
+	if list := g.childList(item.Location); list != nil && !containsString(*list, itemID) {
+		*list = append(*list, itemID)
+	}
+}
+
+// removeItem takes an item out of play (REMOVE in ZIL)
+func (g *GameV2) removeItem(itemID string) {
+	g.moveItem(itemID, "")
+}
+
+// contentsOf returns the IDs of the items directly inside a parent (a room,
+// "inventory", an NPC or a container)
+func (g *GameV2) contentsOf(parent string) []string {
+	parent = canonicalLocation(parent)
+	if g.Rooms[parent] == nil && g.NPCs[parent] == nil && parent != "inventory" {
+		if list := g.itemContents[parent]; list != nil {
+			return *list
+		}
+		return nil
+	}
+	if list := g.childList(parent); list != nil {
+		return *list
+	}
+	return nil
+}
+
+// allContentsOf returns every item inside a parent, however deeply nested,
+// parents before their children
+func (g *GameV2) allContentsOf(parent string) []string {
+	var all []string
+	for _, id := range g.contentsOf(parent) {
+		all = append(all, id)
+		all = append(all, g.allContentsOf(id)...)
+	}
+	return all
+}
+
+// parentOf returns the item's parent (LOC in ZIL)
+func (g *GameV2) parentOf(itemID string) string {
+	if item := g.Items[itemID]; item != nil {
+		return canonicalLocation(item.Location)
+	}
+	return ""
+}
+
+// isInside reports whether an item is somewhere inside ancestor, through any
+// number of containers
+func (g *GameV2) isInside(itemID, ancestor string) bool {
+	ancestor = canonicalLocation(ancestor)
+	seen := make(map[string]bool)
+	for loc := g.parentOf(itemID); loc != "" && !seen[loc]; loc = g.parentOf(loc) {
+		if loc == ancestor {
+			return true
+		}
+		seen[loc] = true
+	}
+	return false
+}
+
+// outermostLocation follows an item up through its containers and returns
+// the room, "inventory" or NPC that ultimately holds it
+func (g *GameV2) outermostLocation(itemID string) string {
+	loc := g.parentOf(itemID)
+	seen := make(map[string]bool)
+	for g.Items[loc] != nil && !seen[loc] {
+		seen[loc] = true
+		loc = g.parentOf(loc)
+	}
+	return loc
+}
+
+// rebuildContainment makes every child list agree with Location. It runs
+// after world setup, where items are created with just a Location, and after
+// a restore. Existing list order is kept; missing items are appended in ID
+// order so room listings are stable.
+func (g *GameV2) rebuildContainment() {
+	ids := sortedItemIDs(g)
+	for _, id := range ids {
+		g.Items[id].Location = canonicalLocation(g.Items[id].Location)
+	}
+
+	belongs := func(parent string) func(string) bool {
+		return func(id string) bool {
+			item := g.Items[id]
+			return item != nil && (item.Location == parent || item.Location == "GLOBAL")
+		}
+	}
+	for id, room := range g.Rooms {
+		room.Contents = filterStrings(room.Contents, belongs(id))
+	}
+	g.Player.Inventory = filterStrings(g.Player.Inventory, belongs("inventory"))
+	for id, npc := range g.NPCs {
+		npc.Inventory = filterStrings(npc.Inventory, belongs(id))
+	}
+	g.itemContents = make(map[string]*[]string)
+
+	for _, id := range ids {
+		if list := g.childList(g.Items[id].Location); list != nil && !containsString(*list, id) {
+			*list = append(*list, id)
+		}
+	}
+
+	for id, room := range g.Rooms {
+		room.NPCs = filterStrings(room.NPCs, func(npcID string) bool {
+			return g.NPCs[npcID] != nil && g.NPCs[npcID].Location == id
+		})
+	}
+	for _, npc := range g.NPCs {
+		if room := g.Rooms[npc.Location]; room != nil && !room.HasNPC(npc.ID) {
+			room.AddNPC(npc.ID)
+		}
+	}
+}
+
+// sortedItemIDs returns the IDs of all items in a stable order, skipping
+// second keys for the same item (bag-of-coins -> coins)
+func sortedItemIDs(g *GameV2) []string {
+	ids := make([]string, 0, len(g.Items))
+	for key, item := range g.Items {
+		if key == item.ID {
+			ids = append(ids, key)
+		}
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// filterStrings returns the elements of list for which keep is true
+func filterStrings(list []string, keep func(string) bool) []string {
+	result := make([]string, 0, len(list))
+	for _, s := range list {
+		if keep(s) {
+			result = append(result, s)
+		}
+	}
+	return result
+}
+
+// moveNPC moves an NPC to a room, or out of play with an empty room ID
+func (g *GameV2) moveNPC(npc *NPC, roomID string) {
+	if room := g.Rooms[npc.Location]; room != nil {
+		room.RemoveNPC(npc.ID)
+	}
+	npc.Location = roomID
+	if room := g.Rooms[roomID]; room != nil && !room.HasNPC(npc.ID) {
+		room.AddNPC(npc.ID)
+	}
+}
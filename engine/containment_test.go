@@ -0,0 +1,86 @@
+package engine
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMoveItemKeepsViewsInStep(t *testing.T) {
+	g := NewGameV2("test")
+
+	g.moveItem("lamp", "inventory")
+	if g.Items["lamp"].Location != "inventory" {
+		t.Errorf("lamp Location = %q, want inventory", g.Items["lamp"].Location)
+	}
+	if containsString(g.Rooms["living-room"].Contents, "lamp") {
+		t.Error("lamp still listed in living-room after taking it")
+	}
+	if !containsString(g.Player.Inventory, "lamp") {
+		t.Error("lamp not listed in inventory")
+	}
+
+	g.moveItem("lamp", "trophy-case")
+	if !containsString(g.contentsOf("trophy-case"), "lamp") {
+		t.Error("lamp not listed in trophy case")
+	}
+	if containsString(g.Player.Inventory, "lamp") {
+		t.Error("lamp still in inventory after putting it in the case")
+	}
+
+	g.removeItem("lamp")
+	if g.Items["lamp"].Location != "" || len(g.contentsOf("trophy-case")) != 0 {
+		t.Errorf("lamp not out of play: Location %q, case holds %v", g.Items["lamp"].Location, g.contentsOf("trophy-case"))
+	}
+
+	if problems := g.CheckInvariants(); len(problems) > 0 {
+		t.Errorf("invariants broken: %v", problems)
+	}
+}
+
+func TestNestedContainers(t *testing.T) {
+	g := NewGameV2("test")
+
+	// Bag, then bottle, then leaflet: three levels deep
+	g.moveItem("sandwich-bag", "inventory")
+	g.moveItem("bottle", "sandwich-bag")
+	g.moveItem("leaflet", "bottle")
+
+	want := []string{"lunch", "bottle", "leaflet"}
+	if got := g.allContentsOf("sandwich-bag"); !reflect.DeepEqual(got, want) {
+		t.Errorf("allContentsOf(sandwich-bag) = %v, want %v", got, want)
+	}
+	if !g.isInside("leaflet", "inventory") {
+		t.Error("leaflet should be inside the inventory through two containers")
+	}
+	if g.isInside("leaflet", "mailbox") {
+		t.Error("leaflet should no longer be inside the mailbox")
+	}
+	if got := g.outermostLocation("leaflet"); got != "inventory" {
+		t.Errorf("outermostLocation(leaflet) = %q, want inventory", got)
+	}
+
+	// Dropping the bag carries everything inside it along
+	g.moveItem("sandwich-bag", "kitchen")
+	if got := g.outermostLocation("leaflet"); got != "kitchen" {
+		t.Errorf("outermostLocation(leaflet) after drop = %q, want kitchen", got)
+	}
+}
+
+func TestRebuildContainmentAfterRestore(t *testing.T) {
+	g := NewGameV2("test")
+	g.moveItem("leaflet", "inventory")
+	g.moveItem("sword", "mailbox")
+
+	restored := NewGameV2("test")
+	restored.deserializeState(g.serializeState())
+
+	if !containsString(restored.contentsOf("mailbox"), "sword") {
+		t.Errorf("mailbox holds %v after restore, want sword", restored.contentsOf("mailbox"))
+	}
+	if containsString(restored.Rooms["living-room"].Contents, "sword") {
+		t.Error("sword still listed in living-room after restore")
+	}
+	if problems := restored.CheckInvariants(); len(problems) > 0 {
+		t.Errorf("invariants broken after restore: %v", problems)
+	}
+}
@@ -0,0 +1,302 @@
+package engine
+
+// Flood Control Dam #3 (BUTTON-F, BOLT-F, I-REMPTY, I-RFILL, I-MAINT-ROOM,
+// LEAK-FUNCTION and the dam and reservoir room functions in ZIL)
+//
+// The yellow button in the Maintenance Room lets the bolt on the dam's
+// control panel turn; the brown one locks it again. Turning the bolt with
+// the wrench opens or closes the sluice gates, and the reservoir behind the
+// dam drains or fills over the next few turns. The blue button bursts a pipe
+// and floods the Maintenance Room unless the leak is plugged with putty.
+
+// reservoirTurns is how long the reservoir takes to drain or fill (I-REMPTY
+// and I-RFILL in ZIL)
+const reservoirTurns = 8
+
+// floodLevel is the water level at which the Maintenance Room is full
+// (I-MAINT-ROOM in ZIL)
+const floodLevel = 14
+
+// drownings describes the rising water, one entry for every two levels
+// (DROWNINGS in ZIL)
+var drownings = []string{
+	"up to your ankles.",
+	"up to your shin.",
+	"up to your knees.",
+	"up to your hips.",
+	"up to your waist.",
+	"up to your chest.",
+	"up to your neck.",
+	"over your head.",
+	"high in your lungs.",
+}
+
+// pushDamButton presses one of the buttons in the Maintenance Room
+// (BUTTON-F in ZIL)
+func (g *GameV2) pushDamButton(button *Item) string {
+	switch button.ID {
+	case "yellow-button":
+		g.Flags["gate-flag"] = true
+		return "Click."
+	case "brown-button":
+		g.Flags["gate-flag"] = false
+		return "Click."
+	case "red-button":
+		room := g.Rooms["maintenance-room"]
+		room.Flags.IsDark = !room.Flags.IsDark
+		if room.Flags.IsDark {
+			return "The lights within the room shut off."
+		}
+		return "The lights within the room come on."
+	case "blue-button":
+		if g.WaterLevel != 0 {
+			return "The blue button appears to be jammed."
+		}
+		g.WaterLevel = 1
+		g.Items["leak"].Flags.IsInvisible = false
+		return "There is a rumbling sound and a stream of water appears to burst from the east wall of the room (apparently, a leak has occurred in a pipe)."
+	}
+	return "Click."
+}
+
+// turnBolt opens or closes the sluice gates, if the yellow button has freed
+// the bolt (BOLT-F in ZIL)
+func (g *GameV2) turnBolt(toolName string) string {
+	if toolName == "" {
+		return "Turn it with what?"
+	}
+	tool := g.findItem(toolName)
+	if tool == nil {
+		return "You can't see any " + toolName + " here."
+	}
+	if tool.ID != "wrench" {
+		return "The bolt won't turn using the " + tool.Name + "."
+	}
+	if !g.Flags["gate-flag"] {
+		return "The bolt won't turn with your best effort."
+	}
+
+	g.ReservoirTurns = reservoirTurns
+	if g.Flags["dam-open"] {
+		g.Flags["dam-open"] = false
+		return "The sluice gates close and water starts to collect behind the dam."
+	}
+	g.Flags["dam-open"] = true
+	return "The sluice gates open and water pours through the dam."
+}
+
+// processReservoir drains or fills the reservoir once the gates have been
+// open or shut for long enough (I-REMPTY and I-RFILL in ZIL)
+func (g *GameV2) processReservoir() string {
+	if g.ReservoirTurns == 0 || g.GameOver {
+		return ""
+	}
+	g.ReservoirTurns--
+	if g.ReservoirTurns > 0 {
+		return ""
+	}
+	if g.Flags["dam-open"] {
+		return g.emptyReservoir()
+	}
+	return g.fillReservoir()
+}
+
+// emptyReservoir leaves the reservoir a mud flat (I-REMPTY in ZIL)
+func (g *GameV2) emptyReservoir() string {
+	g.Flags["low-tide"] = true
+	if trunk := g.Items["trunk-of-jewels"]; trunk != nil && trunk.Location == "reservoir" {
+		trunk.Flags.IsInvisible = false
+	}
+
+	switch g.Location {
+	case "reservoir":
+		if g.Player.Vehicle != "" {
+			return "The water level has dropped to the point at which the boat can no longer stay afloat. It sinks into the mud."
+		}
+	case "deep-canyon":
+		return "The roar of rushing water is quieter now."
+	case "reservoir-north", "reservoir-south":
+		return "The water level is now quite low here and you could easily cross over to the other side."
+	}
+	return ""
+}
+
+// fillReservoir floods the reservoir again (I-RFILL in ZIL)
+func (g *GameV2) fillReservoir() string {
+	g.Flags["low-tide"] = false
+	if trunk := g.Items["trunk-of-jewels"]; trunk != nil && trunk.Location == "reservoir" {
+		trunk.Flags.IsInvisible = true
+	}
+
+	switch g.Location {
+	case "reservoir":
+		if g.Player.Vehicle != "" {
+			return "The boat lifts gently out of the mud and is now floating on the reservoir."
+		}
+		return g.jigsUp("You are lifted up by the rising river! You try to swim, but the currents are too strong. You come closer, closer to the awesome structure of Flood Control Dam #3. The dam beckons to you. The roar of the water nearly deafens you, but you remain conscious as you tumble over the dam toward your certain doom among the rocks at its base.")
+	case "deep-canyon":
+		return "A sound, like that of flowing water, starts to come from below."
+	case "loud-room":
+		return "All of a sudden, an alarmingly loud roaring sound fills the room. Filled with fear, you scramble away.\n\n" +
+			g.goTo(loudRuns[g.randomInt(len(loudRuns))])
+	case "reservoir-north", "reservoir-south":
+		return "You notice that the water level has risen to the point that it is impossible to cross."
+	}
+	return ""
+}
+
+// processLeak lets the water rise in the Maintenance Room until the leak is
+// fixed or the room is full (I-MAINT-ROOM in ZIL)
+func (g *GameV2) processLeak() string {
+	if g.WaterLevel <= 0 || g.Flags["maintenance-flooded"] || g.GameOver {
+		return ""
+	}
+
+	here := g.Location == "maintenance-room"
+	result := ""
+	if here {
+		result = "The water level here is now " + drownings[g.WaterLevel/2]
+	}
+	g.WaterLevel++
+
+	if g.WaterLevel >= floodLevel {
+		g.Flags["maintenance-flooded"] = true
+		if here {
+			return g.jigsUp(result + "\n\nI'm afraid you have done drowned yourself.")
+		}
+		return result
+	}
+	if g.Player.Vehicle != "" && (g.Location == "maintenance-room" || g.Location == "dam-room" || g.Location == "dam-lobby") {
+		return g.jigsUp("The rising water carries the boat over the dam, down the river, and over the falls. Tsk, tsk.")
+	}
+	return result
+}
+
+// plugLeak stops the leak with putty (LEAK-FUNCTION and FIX-MAINT-LEAK in
+// ZIL)
+func (g *GameV2) plugLeak(materialName string) string {
+	if g.WaterLevel <= 0 {
+		return "You can't see any leak here."
+	}
+	if materialName == "" {
+		return "Plug it with what?"
+	}
+	material := g.findItem(materialName)
+	if material == nil {
+		return "You can't see any " + materialName + " here."
+	}
+	if material.ID != "putty" {
+		return "With a " + material.Name + "??!?"
+	}
+
+	g.WaterLevel = -1
+	g.Items["leak"].Flags.IsInvisible = true
+	return "By some miracle of Zorkian technology, you have managed to stop the leak in the dam."
+}
+
+// damBlocks keeps the player out of the flooded Maintenance Room (RMUNGBIT
+// in ZIL). It returns "" if the move is allowed.
+func (g *GameV2) damBlocks(to string) string {
+	if to == "maintenance-room" && g.Flags["maintenance-flooded"] {
+		return "The room is full of water and cannot be entered."
+	}
+	return ""
+}
+
+// damRoomAction describes the dam, its gates and its control panel
+// (DAM-ROOM-FCN in ZIL)
+func damRoomAction(g *GameV2, event RoomEvent) string {
+	if event != EventLook {
+		return ""
+	}
+
+	description := g.Rooms["dam-room"].Description + " "
+	open, low := g.Flags["dam-open"], g.Flags["low-tide"]
+	switch {
+	case open && low:
+		description += "The water level behind the dam is low: The sluice gates have been opened. Water rushes through the dam and downstream."
+	case open:
+		description += "The sluice gates are open, and water rushes through the dam. The water level behind the dam is still high."
+	case low:
+		description += "The sluice gates are closed. The water level in the reservoir is quite low, but the level is rising quickly."
+	default:
+		description += "The sluice gates on the dam are closed. Behind the dam, there can be seen a wide reservoir. Water is pouring over the top of the now abandoned dam."
+	}
+
+	description += "\nThere is a control panel here, on which a large metal bolt is mounted. Directly above the bolt is a small green plastic bubble"
+	if g.Flags["gate-flag"] {
+		description += " which is glowing serenely"
+	}
+	return description + "."
+}
+
+// reservoirSouthAction describes the south shore as the water level changes
+// (RESERVOIR-SOUTH-FCN in ZIL)
+func reservoirSouthAction(g *GameV2, event RoomEvent) string {
+	if event != EventLook {
+		return ""
+	}
+
+	var description string
+	open, low := g.Flags["dam-open"], g.Flags["low-tide"]
+	switch {
+	case open && low:
+		description = "You are in a long room, to the north of which was formerly a lake. However, with the water level lowered, there is merely a wide stream running through the center of the room."
+	case open:
+		description = "You are in a long room. To the north is a large lake, too deep to cross. You notice, however, that the water level appears to be dropping at a rapid rate. Before long, it might be possible to cross to the other side from here."
+	case low:
+		description = "You are in a long room, to the north of which is a wide area which was formerly a reservoir, but now is merely a stream. You notice, however, that the level of the stream is rising quickly and that before long it will be impossible to cross here."
+	default:
+		description = g.Rooms["reservoir-south"].Description
+	}
+	return description + "\nThere is a path along the stream to the east or west, a steep pathway climbing southwest along the edge of a chasm, and a path leading into a canyon to the southeast."
+}
+
+// reservoirNorthAction describes the north shore as the water level changes
+// (RESERVOIR-NORTH-FCN in ZIL)
+func reservoirNorthAction(g *GameV2, event RoomEvent) string {
+	if event != EventLook {
+		return ""
+	}
+
+	var description string
+	open, low := g.Flags["dam-open"], g.Flags["low-tide"]
+	switch {
+	case open && low:
+		description = "You are in a large cavernous room, the south of which was formerly a lake. However, with the water level lowered, there is merely a wide stream running through there."
+	case open:
+		description = "You are in a large cavernous area. To the south is a wide lake, whose water level appears to be falling rapidly."
+	case low:
+		description = "You are in a cavernous area, to the south of which is a very wide stream. The level of the stream is rising rapidly, and it appears that before long it will be impossible to cross to the other side."
+	default:
+		description = g.Rooms["reservoir-north"].Description
+	}
+	return description + "\nThere is a slimy stairway leaving the room to the north."
+}
+
+// reservoirAction describes the reservoir, full or drained (RESERVOIR-FCN
+// in ZIL)
+func reservoirAction(g *GameV2, event RoomEvent) string {
+	if event != EventLook || !g.Flags["low-tide"] {
+		return ""
+	}
+	return "You are on what used to be a large lake, but which is now a large mud pile. There are \"shores\" to the north and south."
+}
+
+// deepCanyonAction lets the player hear the water below (DEEP-CANYON-F in
+// ZIL)
+func deepCanyonAction(g *GameV2, event RoomEvent) string {
+	if event != EventLook {
+		return ""
+	}
+
+	description := g.Rooms["deep-canyon"].Description
+	open, low := g.Flags["dam-open"], g.Flags["low-tide"]
+	switch {
+	case open && !low:
+		return description + " You can hear a loud roaring sound, like that of rushing water, from below."
+	case !open && low:
+		return description
+	}
+	return description + " You can hear the sound of flowing water from below."
+}
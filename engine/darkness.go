@@ -0,0 +1,32 @@
+package engine
+
+// Darkness and the grue (LIT, GOTO and V-WALK in ZIL)
+//
+// The grue never strikes a player who stands still. The first move into the
+// dark only warns ("It is pitch black..."); every move made while already in
+// the dark has an 80% chance of being the last.
+
+// grueChance is the percent chance of being eaten when moving in the dark
+const grueChance = 80
+
+// DarknessTracker remembers whether the player was in the dark at the end of
+// the last turn or move (LIT in ZIL)
+type DarknessTracker struct {
+	InDarkness bool `json:"in_darkness"`
+}
+
+// inDarkness reports whether the player can see nothing where they are
+func (g *GameV2) inDarkness() bool {
+	room := g.Rooms[g.Location]
+	return room != nil && room.Flags.IsDark && !g.hasLight()
+}
+
+// updateDarkness records whether the player ended the turn in the dark
+func (g *GameV2) updateDarkness() {
+	g.Darkness.InDarkness = g.inDarkness()
+}
+
+// grueAttacks rolls for the grue when the player moves while in the dark
+func (g *GameV2) grueAttacks() bool {
+	return g.randomChance(g.withLuck(grueChance))
+}
@@ -0,0 +1,58 @@
+package engine
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+func TestFirstMoveIntoDarknessOnlyWarns(t *testing.T) {
+	for seed := int64(1); seed <= 50; seed++ {
+		g := NewGameV2("test")
+		g.rand = rand.New(rand.NewSource(seed))
+		g.Location = "living-room"
+		g.Items["trap-door"].Flags.IsOpen = true
+		g.Flags["trap-door-open"] = true
+
+		result := g.Process("down")
+		if g.Deaths != 0 {
+			t.Fatalf("seed %d: grue struck on the first move into the dark", seed)
+		}
+		if !strings.Contains(result, "pitch black") || !g.Darkness.InDarkness {
+			t.Fatalf("seed %d: expected darkness warning, got: %s", seed, result)
+		}
+	}
+}
+
+func TestMovingInDarknessIsUsuallyFatal(t *testing.T) {
+	deaths := 0
+	trials := 200
+	for seed := int64(1); seed <= int64(trials); seed++ {
+		g := NewGameV2("test")
+		g.rand = rand.New(rand.NewSource(seed))
+		g.Location = "cellar"
+		g.Process("look")
+
+		g.Process("north")
+		if g.Deaths > 0 {
+			deaths++
+		}
+	}
+	// 80% in ZIL; allow for chance
+	if deaths < trials*65/100 || deaths > trials*95/100 {
+		t.Errorf("grue killed %d of %d players moving in the dark, want about 80%%", deaths, trials)
+	}
+}
+
+func TestDarknessTrackerSurvivesRestore(t *testing.T) {
+	g := NewGameV2("test")
+	g.Location = "cellar"
+	g.Process("look")
+	g.Process("wait")
+
+	restored := NewGameV2("test")
+	restored.deserializeState(g.serializeState())
+	if restored.Darkness != g.Darkness || !restored.Darkness.InDarkness {
+		t.Errorf("Darkness = %+v after restore, want %+v", restored.Darkness, g.Darkness)
+	}
+}
@@ -0,0 +1,157 @@
+package engine
+
+import (
+	"sort"
+	"strings"
+)
+
+// Death and resurrection (JIGS-UP, RANDOMIZE-OBJECTS and DEAD-FUNCTION in ZIL)
+
+// maxDeaths is how many times the player is brought back before the game
+// gives up on them (DEATHS in ZIL)
+const maxDeaths = 2
+
+// aboveGroundRooms are where the player's non-treasure belongings end up
+// after a death (ABOVE-GROUND in ZIL)
+var aboveGroundRooms = []string{
+	"west-of-house", "north-of-house", "behind-house", "south-of-house",
+	"forest-1", "forest-2", "forest-3", "path", "clearing", "grating-clearing",
+	"canyon-view",
+}
+
+// jigsUp kills the player (JIGS-UP in ZIL). The first deaths cost 10 points
+// and scatter the player's belongings; after that the game ends. Once the
+// temple has been visited, dying turns the player into a spirit at the gates
+// of Hades instead of sending them back to the forest.
+func (g *GameV2) jigsUp(desc string) string {
+	var result strings.Builder
+
+	if g.Dead {
+		g.GameOver = true
+		result.WriteString(desc + "\n\n")
+		result.WriteString("It takes a talented person to be killed while already dead. YOU are such a talent. Unfortunately, it takes a talented person to deal with it. I am not such a talent. Sorry.\n\n")
+		result.WriteString(g.handleScore())
+		return result.String()
+	}
+
+	result.WriteString(desc + "\n\n")
+	g.Score -= 10
+	result.WriteString("    ****  You have died  ****\n\n")
+
+	if g.Deaths >= maxDeaths {
+		g.GameOver = true
+		result.WriteString("You clearly are a suicidal maniac. We don't allow psychotics in the cave, since they may harm other adventurers. Your remains will be installed in the Land of the Living Dead, where your fellow adventurers may gaze upon them.\n\n")
+		result.WriteString(g.handleScore())
+		return result.String()
+	}
+
+	g.Deaths++
+	g.Player.Health = 100
+	g.Player.StrengthModifier = 0 // Wounds die with the player
+	g.Player.CureTurns = 0
+	g.Player.Staggered = false
+	g.Player.Vehicle = ""
+	g.RiverTurns = 0
+	g.randomizeObjects()
+
+	if temple := g.Rooms["south-temple"]; temple != nil && !temple.FirstVisit {
+		g.Dead = true
+		result.WriteString("As you take your last breath, you feel relieved of your burdens. The feeling passes as you find yourself before the gates of Hell, where the spirits jeer at you and deny you entry. Your senses are disturbed. The objects in the dungeon appear indistinct, bleached of color, even unreal.\n\n")
+		result.WriteString(g.goTo("entrance-to-hades"))
+		return result.String()
+	}
+
+	result.WriteString("Now, let's take a look here...\nWell, you probably deserve another chance. I can't quite fix you up completely, but you can't have everything.\n\n")
+	result.WriteString(g.goTo("forest-1"))
+	return result.String()
+}
+
+// goTo puts the player in a room and describes it (GOTO in ZIL)
+func (g *GameV2) goTo(roomID string) string {
+	g.Location = roomID
+	if room := g.Rooms[roomID]; room != nil {
+		room.FirstVisit = false
+	}
+	g.scoreRoom(roomID)
+	return g.handleLook()
+}
+
+// randomizeObjects scatters the dead player's belongings (RANDOMIZE-OBJECTS
+// in ZIL). The lamp goes home to the living room and the coffin to its tomb;
+// treasures are hidden in dark rooms and everything else is dropped somewhere
+// above ground.
+func (g *GameV2) randomizeObjects() {
+	var darkRooms []string
+	for id, room := range g.Rooms {
+		if room.Flags.IsDark && !room.Flags.IsUnderwater && !strings.HasPrefix(id, "river") {
+			darkRooms = append(darkRooms, id)
+		}
+	}
+	sort.Strings(darkRooms)
+
+	for _, id := range append([]string{}, g.Player.Inventory...) {
+		item := g.Items[id]
+		if item == nil {
+			continue
+		}
+		switch {
+		case item.ID == "lamp":
+			g.moveItem(id, "living-room")
+		case item.ID == "coffin":
+			g.moveItem(id, "egypt-room")
+		case item.Flags.IsTreasure && len(darkRooms) > 0:
+			g.moveItem(id, darkRooms[g.randomInt(len(darkRooms))])
+		default:
+			g.moveItem(id, aboveGroundRooms[g.randomInt(len(aboveGroundRooms))])
+		}
+	}
+}
+
+// deadFunction handles the player's commands while they are a spirit
+// (DEAD-FUNCTION in ZIL). It returns false for commands that work as usual.
+func (g *GameV2) deadFunction(cmd *Command) (string, bool) {
+	switch cmd.Verb {
+	case "walk":
+		if g.Location == "timber-room" && cmd.Direction == "west" {
+			return "You cannot enter in your condition.", true
+		}
+		return "", false
+	case "brief", "verbose", "superbrief", "version", "save", "restore", "quit", "restart",
+		"help", "clear", "cls", "refresh": // Interface commands, not in ZIL
+		return "", false
+	case "attack", "kill", "break", "wake":
+		return "All such attacks are vain in your condition.", true
+	case "open", "close", "eat", "drink", "inflate", "deflate", "turn", "burn", "tie", "untie":
+		return "Even such an action is beyond your capabilities.", true
+	case "wait":
+		return "Might as well. You've got an eternity.", true
+	case "turn-on", "light":
+		return "You need no light to guide you.", true
+	case "score":
+		return "You're dead! How can you think of your score?", true
+	case "take", "touch":
+		return "Your hand passes through its object.", true
+	case "drop", "throw", "inventory":
+		return "You have no possessions.", true
+	case "diagnose":
+		return "You are dead.", true
+	case "look":
+		result := "The room looks strange and unearthly"
+		if room := g.Rooms[g.Location]; room == nil || len(room.Contents) == 0 {
+			result += "."
+		} else {
+			result += " and objects appear indistinct."
+		}
+		if room := g.Rooms[g.Location]; room != nil && room.Flags.IsDark {
+			result += "\nAlthough there is no light, the room seems dimly illuminated."
+		}
+		return result + "\n\n" + g.handleLook(), true
+	case "pray":
+		if g.Location != "south-temple" {
+			return "Your prayers are not heard.", true
+		}
+		g.Dead = false
+		return "From the distance the sound of a lone trumpet is heard. The room becomes very bright and you feel disembodied. In a moment, the brightness fades and you find yourself rising as if from a long sleep, deep in the woods. In the distance you can faintly hear a songbird and the sounds of the forest.\n\n" + g.goTo("forest-1"), true
+	}
+	return "You can't even do that.", true
+}
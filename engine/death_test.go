@@ -0,0 +1,137 @@
+package engine
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+func TestFirstDeathReturnsPlayerToForest(t *testing.T) {
+	g := NewGameV2("test")
+	g.rand = rand.New(rand.NewSource(1))
+	g.Score = 25
+	g.moveItem("lamp", "inventory")
+	g.moveItem("diamond", "inventory")
+	g.moveItem("rope", "inventory")
+
+	result := g.jigsUp("You die.")
+
+	if g.GameOver {
+		t.Fatal("first death should not end the game")
+	}
+	if !strings.Contains(result, "You have died") || !strings.Contains(result, "another chance") {
+		t.Errorf("unexpected death text: %s", result)
+	}
+	if g.Location != "forest-1" {
+		t.Errorf("Location = %q, want forest-1", g.Location)
+	}
+	if g.Score != 15 {
+		t.Errorf("Score = %d, want 15 after the death penalty", g.Score)
+	}
+	if len(g.Player.Inventory) != 0 {
+		t.Errorf("inventory should be scattered, still holding %v", g.Player.Inventory)
+	}
+	if g.Items["lamp"].Location != "living-room" {
+		t.Errorf("lamp went to %q, want living-room", g.Items["lamp"].Location)
+	}
+	if room := g.Rooms[g.Items["diamond"].Location]; room == nil || !room.Flags.IsDark {
+		t.Errorf("diamond went to %q, want a dark room", g.Items["diamond"].Location)
+	}
+	if !containsString(aboveGroundRooms, g.Items["rope"].Location) {
+		t.Errorf("rope went to %q, want an above-ground room", g.Items["rope"].Location)
+	}
+}
+
+func TestDeathHealsWounds(t *testing.T) {
+	g := NewGameV2("test")
+	g.rand = rand.New(rand.NewSource(1))
+	g.Player.Staggered = true
+	g.wound(g.baseFightStrength())
+	if g.Player.Health > 0 {
+		t.Fatal("a wound taking all strength should be fatal")
+	}
+
+	g.jigsUp("The troll's axe finishes you off.")
+
+	if g.Player.StrengthModifier != 0 || g.Player.CureTurns != 0 || g.Player.Staggered {
+		t.Errorf("after death StrengthModifier = %d, CureTurns = %d, Staggered = %v; want a fresh player",
+			g.Player.StrengthModifier, g.Player.CureTurns, g.Player.Staggered)
+	}
+	if result := g.Process("diagnose"); !strings.Contains(result, "perfect health") {
+		t.Errorf("diagnose after death: %s", result)
+	}
+}
+
+func TestRepeatedDeathsEndTheGame(t *testing.T) {
+	g := NewGameV2("test")
+
+	g.jigsUp("One.")
+	g.jigsUp("Two.")
+	if g.GameOver {
+		t.Fatal("game ended after only two deaths")
+	}
+
+	result := g.jigsUp("Three.")
+	if !g.GameOver {
+		t.Error("third death should end the game")
+	}
+	if !strings.Contains(result, "suicidal maniac") {
+		t.Errorf("expected the suicidal maniac ending, got: %s", result)
+	}
+}
+
+func TestSpiritStateAfterTemple(t *testing.T) {
+	g := NewGameV2("test")
+	g.Rooms["south-temple"].FirstVisit = false
+
+	result := g.jigsUp("You die.")
+	if !g.Dead || g.Location != "entrance-to-hades" {
+		t.Fatalf("expected spirit at entrance-to-hades, got dead=%v location=%s", g.Dead, g.Location)
+	}
+	if !strings.Contains(result, "gates of Hell") {
+		t.Errorf("expected the gates of Hell text, got: %s", result)
+	}
+
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"take sword", "Your hand passes through its object."},
+		{"inventory", "You have no possessions."},
+		{"score", "You're dead! How can you think of your score?"},
+		{"open mailbox", "Even such an action is beyond your capabilities."},
+		{"read leaflet", "You can't even do that."},
+		{"pray", "Your prayers are not heard."},
+	}
+	for _, tt := range tests {
+		if got := g.Process(tt.input); !strings.HasPrefix(got, tt.want) {
+			t.Errorf("%q while dead = %q, want %q", tt.input, got, tt.want)
+		}
+	}
+
+	// The state survives a save and restore
+	restored := NewGameV2("test")
+	restored.deserializeState(g.serializeState())
+	if !restored.Dead || restored.Deaths != 1 || restored.Rooms["south-temple"].FirstVisit {
+		t.Errorf("spirit state lost on restore: dead=%v deaths=%d", restored.Dead, restored.Deaths)
+	}
+
+	g.Location = "south-temple"
+	result = g.Process("pray")
+	if g.Dead || g.Location != "forest-1" {
+		t.Errorf("praying in the temple should resurrect in forest-1, got dead=%v location=%s", g.Dead, g.Location)
+	}
+	if !strings.Contains(result, "lone trumpet") {
+		t.Errorf("expected resurrection text, got: %s", result)
+	}
+}
+
+func TestDyingWhileDeadEndsTheGame(t *testing.T) {
+	g := NewGameV2("test")
+	g.Dead = true
+
+	result := g.jigsUp("Again.")
+	if !g.GameOver || !strings.Contains(result, "killed while already dead") {
+		t.Errorf("expected game over for dying as a spirit, got: %s", result)
+	}
+}
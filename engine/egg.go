@@ -0,0 +1,124 @@
+package engine
+
+// The jewel-encrusted egg and the clockwork canary (EGG-OBJECT, BAD-EGG,
+// CANARY-OBJECT and FOREST-ROOM? in ZIL)
+//
+// The egg in the songbird's nest is too delicate for the player to open;
+// forcing it breaks both the egg and the canary inside, and the pieces are
+// worth far less. Only the thief has the skill to open it, which he does
+// once it is back in his lair. Wound in the forest, the canary lures the
+// songbird down to drop its brass bauble.
+
+// forestRooms are where the songbird can hear the canary (FOREST-ROOM? in
+// ZIL)
+var forestRooms = []string{"forest-1", "forest-2", "forest-3", "path", "up-a-tree"}
+
+// openEgg tries to open or break the egg, with a tool or without one
+// (EGG-OBJECT in ZIL)
+func (g *GameV2) openEgg(egg *Item, toolName string, forcing bool) string {
+	if egg.Flags.IsOpen {
+		return "The egg is already open."
+	}
+	if toolName == "" {
+		return "You have neither the tools nor the expertise."
+	}
+	if toolName == "hands" {
+		return "I doubt you could do that without damaging it."
+	}
+
+	tool := g.findItem(toolName)
+	if tool == nil {
+		return "You can't see any " + toolName + " here."
+	}
+	if tool.Flags.IsWeapon || tool.Flags.IsTool || forcing {
+		return "The egg is now open, but the clumsiness of your attempt has seriously compromised its esthetic appeal." + g.badEgg()
+	}
+	if g.Flags["egg-tried"] {
+		return "Not to say that using the " + tool.Name + " isn't original too..."
+	}
+	g.Flags["egg-tried"] = true
+	return "The concept of using a " + tool.Name + " is certainly original."
+}
+
+// crushEgg breaks the egg when it is sat on or thrown (EGG-OBJECT in ZIL)
+func (g *GameV2) crushEgg(thrown bool) string {
+	if thrown {
+		return "Your rather indelicate handling of the egg has caused it damage, although you have succeeded in opening it." + g.badEgg()
+	}
+	return "There is a noticeable crunch from beneath you, and inspection reveals that the egg is lying open, badly damaged." + g.badEgg()
+}
+
+// badEgg swaps the egg, and the canary if it is still inside, for their
+// broken versions (BAD-EGG in ZIL)
+func (g *GameV2) badEgg() string {
+	where := g.parentOf("egg")
+	damage := ""
+	if g.parentOf("canary") == "egg" {
+		g.removeItem("canary")
+		damage = " There is a golden clockwork canary nestled in the egg. It seems to have recently had a bad experience. The mountings for its jewel-like eyes are empty, and its silver beak is crumpled. Through a cracked crystal window below its left wing you can see the remains of intricate machinery. It is not clear what result winding it would have, as the mainspring seems sprung."
+	} else {
+		g.removeItem("broken-canary")
+	}
+
+	// Anything else the player put in the egg falls out with the canary
+	for _, id := range append([]string{}, g.contentsOf("egg")...) {
+		g.moveItem(id, "broken-egg")
+	}
+	g.removeItem("egg")
+	g.moveItem("broken-egg", where)
+	return damage
+}
+
+// solveEgg opens the egg the way only the thief can (DEPOSIT-BOOTY in ZIL)
+func (g *GameV2) solveEgg() {
+	if egg := g.Items["egg"]; egg != nil {
+		g.Flags["egg-solve"] = true
+		egg.Flags.IsOpen = true
+	}
+}
+
+// handleWind winds something up (CANARY-OBJECT in ZIL)
+func (g *GameV2) handleWind(objName string) string {
+	if objName == "" {
+		return "Wind what?"
+	}
+
+	item := g.findItem(objName)
+	if item == nil {
+		return "You can't see any " + objName + " here."
+	}
+
+	switch item.ID {
+	case "canary":
+		if g.Flags["sing-song"] || !containsString(forestRooms, g.Location) {
+			return "The canary chirps blithely, if somewhat tinnily, for a short time."
+		}
+		g.Flags["sing-song"] = true
+		// From up the tree the bauble falls to the path below
+		where := g.Location
+		if where == "up-a-tree" {
+			where = "path"
+		}
+		g.moveItem("bauble", where)
+		return "The canary chirps, slightly off-key, an aria from a forgotten opera. From out of the greenery flies a lovely songbird. It perches on a limb just over your head and opens its beak to sing. As it does so a beautiful brass bauble drops from its mouth, bounces off the top of your head, and lands glimmering in the grass. As the canary winds down, the songbird flies away."
+	case "broken-canary":
+		return "There is an unpleasant grinding noise from inside the canary."
+	}
+	return "You cannot wind up a " + item.Name + "."
+}
+
+// handleHatch tries to hatch something (V-HATCH in ZIL)
+func (g *GameV2) handleHatch(objName string) string {
+	if objName == "" {
+		return "Hatch what?"
+	}
+
+	item := g.findItem(objName)
+	if item == nil {
+		return "You can't see any " + objName + " here."
+	}
+	if item.ID == "egg" {
+		return g.crushEgg(false)
+	}
+	return "Bizarre!"
+}
@@ -0,0 +1,68 @@
+package engine
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestOpeningEggWithoutTools(t *testing.T) {
+	g := NewGameV2("test")
+	g.moveItem("egg", "inventory")
+
+	if result := g.Process("open egg"); !strings.Contains(result, "neither the tools nor the expertise") {
+		t.Errorf("open egg: %s", result)
+	}
+	if g.Items["egg"].Flags.IsOpen || g.Items["egg"].Location != "inventory" {
+		t.Error("The egg should be untouched")
+	}
+}
+
+func TestForcingEggBreaksIt(t *testing.T) {
+	g := NewGameV2("test")
+	g.Location = "forest-1"
+	g.moveItem("egg", "inventory")
+	g.moveItem("screwdriver", "inventory")
+
+	result := g.Process("open egg with screwdriver")
+	if !strings.Contains(result, "seriously compromised its esthetic appeal") || !strings.Contains(result, "mainspring seems sprung") {
+		t.Errorf("open egg with screwdriver: %s", result)
+	}
+	if g.Items["egg"].Location != "" || g.Items["canary"].Location != "" {
+		t.Error("The egg and canary should be gone")
+	}
+	if g.Items["broken-egg"].Location != "inventory" || g.Items["broken-canary"].Location != "broken-egg" {
+		t.Error("The broken egg should be held, with the broken canary inside")
+	}
+	if g.Items["broken-egg"].Value >= g.Items["egg"].Value || g.Items["broken-canary"].Value >= g.Items["canary"].Value {
+		t.Error("The broken treasures should be worth less")
+	}
+
+	if result := g.Process("wind canary"); !strings.Contains(result, "unpleasant grinding noise") {
+		t.Errorf("wind broken canary: %s", result)
+	}
+	if g.Items["bauble"].Location != "" {
+		t.Error("The songbird should keep its bauble")
+	}
+}
+
+func TestWindingCanaryInForest(t *testing.T) {
+	g := NewGameV2("test")
+	g.Location = "up-a-tree"
+	g.moveItem("egg", "inventory")
+	g.solveEgg() // As the thief would
+
+	if result := g.Process("take canary"); !strings.Contains(result, "Taken") {
+		t.Fatalf("take canary: %s", result)
+	}
+	result := g.Process("wind canary")
+	if !strings.Contains(result, "brass bauble drops from its mouth") {
+		t.Errorf("wind canary: %s", result)
+	}
+	if g.Items["bauble"].Location != "path" {
+		t.Errorf("bauble in %q, want it to fall to the path", g.Items["bauble"].Location)
+	}
+
+	if result := g.Process("wind canary"); !strings.Contains(result, "chirps blithely") {
+		t.Errorf("Second wind canary: %s", result)
+	}
+}
@@ -0,0 +1,86 @@
+package engine
+
+import (
+	"fmt"
+	"strings"
+)
+
+// The endgame (SCORE-UPD, WEST-HOUSE, STONE-BARROW-FCN and FINISH in ZIL)
+//
+// Once every treasure is in the trophy case a voice whispers a hint, the
+// ancient map turns up in the case, and a secret path opens from West of
+// House to the stone barrow. Walking into the barrow ends the game. With
+// ContinueAfterWin set the game is summed up but the player may keep
+// exploring.
+
+// barrowText is shown on entering the barrow (STONE-BARROW-FCN in ZIL)
+const barrowText = `Inside the Barrow
+As you enter the barrow, the door closes inexorably behind you. Around you it is dark, but ahead is an enormous cavern, brightly lit. Through its center runs a wide stream. Spanning the stream is a small wooden footbridge, and beyond a path leads into a dark tunnel. Above the bridge, floating in the air, is a large sign. It reads:  All ye who stand before this bridge have completed a great and perilous adventure which has tested your wit and courage. You have mastered the first part of the ZORK trilogy. Those who pass over this bridge must be prepared to undertake an even greater adventure that will severely test your skill and bravery!
+
+The ZORK trilogy continues with "ZORK II: The Wizard of Frobozz" and is completed in "ZORK III: The Dungeon Master."`
+
+// allTreasuresCased reports whether every treasure is in the trophy case
+func (g *GameV2) allTreasuresCased() bool {
+	for id := range takePoints {
+		if !g.isInside(id, "trophy-case") {
+			return false
+		}
+	}
+	return true
+}
+
+// processEndgame watches the trophy case and, once it holds every treasure,
+// makes the map appear in it and opens the path to the barrow (SCORE-UPD in
+// ZIL)
+func (g *GameV2) processEndgame() string {
+	if g.Won || g.GameOver || !g.allTreasuresCased() {
+		return ""
+	}
+	g.Won = true
+	g.Flags["won-flag"] = true
+	g.moveItem("map", "trophy-case")
+	return "An almost inaudible voice whispers in your ear, \"Look to your treasures for the final secret.\""
+}
+
+// enterBarrow finishes the game (STONE-BARROW-FCN and FINISH in ZIL)
+func (g *GameV2) enterBarrow() string {
+	result := barrowText + "\n\n" + g.handleScore() + "\n\n" + g.statistics()
+	if !g.ContinueAfterWin {
+		g.GameOver = true
+	}
+	return result
+}
+
+// statistics sums up the adventure for the closing screen
+func (g *GameV2) statistics() string {
+	visited := 0
+	for _, room := range g.Rooms {
+		if !room.FirstVisit {
+			visited++
+		}
+	}
+	cased := 0
+	for id := range takePoints {
+		if g.isInside(id, "trophy-case") {
+			cased++
+		}
+	}
+
+	lines := []string{
+		"Statistics:",
+		fmt.Sprintf("  Moves:            %d", g.Moves),
+		fmt.Sprintf("  Deaths:           %d", g.Deaths),
+		fmt.Sprintf("  Rooms explored:   %d of %d", visited, len(g.Rooms)),
+		fmt.Sprintf("  Treasures cased:  %d of %d", cased, len(takePoints)),
+	}
+	return strings.Join(lines, "\n")
+}
+
+// westOfHouseAction shows the secret path once the game is won (WEST-HOUSE
+// in ZIL)
+func westOfHouseAction(g *GameV2, event RoomEvent) string {
+	if event != EventLook || !g.Flags["won-flag"] {
+		return ""
+	}
+	return g.Rooms["west-of-house"].Description + " A secret path leads southwest into the forest."
+}
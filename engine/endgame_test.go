@@ -0,0 +1,98 @@
+package engine
+
+import (
+	"strings"
+	"testing"
+)
+
+// caseAllButOne puts every treasure but the diamond in the trophy case
+func caseAllButOne(g *GameV2) {
+	for id := range takePoints {
+		if id == "diamond" || id == "canary" {
+			continue
+		}
+		g.moveItem(id, "trophy-case")
+	}
+	g.moveItem("canary", "egg")
+}
+
+func TestLastTreasureOpensBarrow(t *testing.T) {
+	g := NewGameV2("test")
+	g.Location = "living-room"
+	g.Items["trophy-case"].Flags.IsOpen = true
+	caseAllButOne(g)
+	g.moveItem("diamond", "inventory")
+
+	if g.Process("west"); g.Flags["won-flag"] {
+		t.Fatal("The barrow should not open before every treasure is cased")
+	}
+	g.Location = "living-room"
+
+	result := g.Process("put diamond in case")
+	if !strings.Contains(result, "Look to your treasures for the final secret.") {
+		t.Errorf("Expected the whisper, got: %s", result)
+	}
+	if !g.Won || !g.Flags["won-flag"] {
+		t.Error("Casing the last treasure should win the game")
+	}
+	if !g.isInside("map", "trophy-case") {
+		t.Error("The map should appear in the trophy case")
+	}
+	if result := g.Process("read map"); !strings.Contains(result, `marked "To Stone Barrow"`) {
+		t.Errorf("read map: %s", result)
+	}
+
+	g.Location = "west-of-house"
+	if result := g.Process("look"); !strings.Contains(result, "secret path leads southwest") {
+		t.Errorf("Expected the secret path, got: %s", result)
+	}
+	if result := g.Process("sw"); !strings.Contains(result, "Stone Barrow") {
+		t.Errorf("sw: %s", result)
+	}
+}
+
+func TestMapStaysHiddenUntilCaseIsFull(t *testing.T) {
+	g := NewGameV2("test")
+	g.Location = "living-room"
+	caseAllButOne(g)
+
+	if result := g.Process("look in case"); strings.Contains(result, "map") {
+		t.Errorf("The map should not be in the case yet: %s", result)
+	}
+	g.moveItem("diamond", "trophy-case")
+	if result := g.Process("wait"); !strings.Contains(result, "Look to your treasures") {
+		t.Errorf("Expected the whisper at the end of the turn, got: %s", result)
+	}
+	if result := g.Process("look in case"); !strings.Contains(result, "ancient map") {
+		t.Errorf("The map should be in the case: %s", result)
+	}
+}
+
+func TestEnteringBarrowFinishesGame(t *testing.T) {
+	g := NewGameV2("test")
+	g.Location = "stone-barrow"
+
+	result := g.Process("enter barrow")
+	for _, want := range []string{"ZORK II: The Wizard of Frobozz", "This gives you the rank of", "Statistics:", "Moves:"} {
+		if !strings.Contains(result, want) {
+			t.Errorf("Expected %q when entering the barrow, got: %s", want, result)
+		}
+	}
+	if !g.GameOver {
+		t.Error("Entering the barrow should end the game")
+	}
+}
+
+func TestContinueAfterWin(t *testing.T) {
+	g := NewGameV2("test")
+	g.ContinueAfterWin = true
+	g.Location = "stone-barrow"
+
+	g.Process("west")
+	if g.GameOver {
+		t.Error("The game should go on when continuing after a win")
+	}
+	if result := g.Process("ne"); !strings.Contains(result, "West of House") {
+		t.Errorf("ne: %s", result)
+	}
+}
@@ -0,0 +1,110 @@
+package engine
+
+import (
+	"fmt"
+	"strings"
+)
+
+// The fight daemon (I-FIGHT in ZIL)
+//
+// Villains don't wait to be attacked. An aggressive villain in the player's
+// room may strike first, and a villain who has been attacked fights back.
+// Once a fight has started the villain swings at the player every turn until
+// one of them dies, the villain is knocked out or disarmed, or the player
+// flees the room.
+
+// wakeChanceStep is how much more likely a knocked-out villain is to come
+// round with each turn he stays down
+const wakeChanceStep = 25
+
+// processFight runs one turn of the fight daemon
+func (g *GameV2) processFight() string {
+	if g.Dead || g.GameOver {
+		return ""
+	}
+
+	// Villains the player has fled from stop fighting
+	for _, npc := range g.NPCs {
+		if npc.Location != g.Location {
+			npc.Flags.IsFighting = false
+		}
+	}
+
+	room := g.Rooms[g.Location]
+	if room == nil {
+		return ""
+	}
+
+	var messages []string
+	for _, npcID := range append([]string{}, room.NPCs...) {
+		npc := g.NPCs[npcID]
+		if npc == nil || !npc.Flags.IsAlive || !npc.Flags.CanFight || npc.Flags.IsInvisible {
+			continue
+		}
+
+		if npc.Flags.IsUnconscious {
+			if npc.WakeChance > 0 && g.randomChance(npc.WakeChance) {
+				messages = append(messages, g.awaken(npc))
+			} else {
+				npc.WakeChance += wakeChanceStep
+			}
+			continue
+		}
+
+		if !npc.Flags.IsFighting {
+			if !g.villainStrikesFirst(npc) {
+				continue
+			}
+			npc.Flags.IsFighting = true
+		}
+
+		if !g.npcWieldsWeapon(npc) {
+			messages = append(messages, g.villainDisarmed(npc))
+			continue
+		}
+
+		messages = append(messages, strings.TrimSpace(g.fightBack(npc)))
+
+		// A death moves the player away from the fight
+		if g.Location != room.ID || g.GameOver {
+			break
+		}
+	}
+
+	return strings.Join(messages, "\n")
+}
+
+// villainStrikesFirst decides whether a villain who isn't fighting yet
+// attacks the player this turn (F-FIRST? in ZIL). Only aggressive villains,
+// ones the player has provoked, or a cornered thief start fights.
+func (g *GameV2) villainStrikesFirst(npc *NPC) bool {
+	vData, ok := villainData[npc.ID]
+	if !ok || vData.FirstStrike == 0 {
+		return false
+	}
+	if !npc.Flags.IsAggressive && !npc.Hostile && !g.thiefProvoked(npc) {
+		return false
+	}
+	return g.randomChance(vData.FirstStrike)
+}
+
+// villainDisarmed spends a disarmed villain's turn: he grabs his weapon if
+// it is still lying there, and otherwise gives up the fight (F-BUSY? in ZIL)
+func (g *GameV2) villainDisarmed(npc *NPC) string {
+	if weapon := g.Items[npc.Weapon]; weapon != nil && weapon.Location == npc.Location {
+		g.removeItem(weapon.ID)
+		switch npc.ID {
+		case "troll":
+			return "The troll, angered and humiliated, recovers his weapon. He appears to have an axe to grind with you."
+		case "thief":
+			return "The robber, somewhat surprised at this turn of events, nimbly retrieves his stiletto."
+		}
+		return fmt.Sprintf("The %s recovers his %s.", npc.Name, weapon.Name)
+	}
+
+	npc.Flags.IsFighting = false
+	if npc.ID == "troll" {
+		return "The troll, disarmed, cowers in terror, pleading for his life in the guttural tongue of the trolls."
+	}
+	return fmt.Sprintf("The %s, disarmed, backs away from you.", npc.Name)
+}
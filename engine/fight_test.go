@@ -0,0 +1,99 @@
+package engine
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+// armedInTrollRoom puts a player with a lit lamp and the sword in the troll room
+func armedInTrollRoom(seed int64) *GameV2 {
+	g := NewGameV2("test")
+	g.rand = rand.New(rand.NewSource(seed))
+	g.Location = "troll-room"
+	g.moveItem("sword", "inventory")
+	g.moveItem("lamp", "inventory")
+	g.Items["lamp"].Flags.IsLit = true
+	return g
+}
+
+func TestTrollAttacksUnprovoked(t *testing.T) {
+	for seed := int64(1); seed <= 20; seed++ {
+		g := armedInTrollRoom(seed)
+		troll := g.NPCs["troll"]
+
+		for i := 0; i < 20 && !troll.Flags.IsFighting; i++ {
+			g.Process("wait")
+		}
+		if !troll.Flags.IsFighting {
+			t.Fatalf("seed %d: the troll never started a fight in 20 turns", seed)
+		}
+	}
+}
+
+func TestAttackedVillainKeepsFighting(t *testing.T) {
+	g := NewGameV2("test")
+	g.rand = rand.New(rand.NewSource(1))
+	g.Location = "cyclops-room"
+	g.moveItem("sword", "inventory")
+	g.moveItem("lamp", "inventory")
+	g.Items["lamp"].Flags.IsLit = true
+	cyclops := g.NPCs["cyclops"]
+
+	// The cyclops never starts a fight on his own
+	for i := 0; i < 10; i++ {
+		g.Process("wait")
+	}
+	if cyclops.Flags.IsFighting || g.Deaths > 0 {
+		t.Fatal("the cyclops should not attack until provoked")
+	}
+
+	g.Process("attack cyclops")
+	if !cyclops.Flags.IsFighting || !cyclops.Hostile {
+		t.Fatal("attacking the cyclops should start a fight")
+	}
+	for i := 0; i < 20 && g.Deaths == 0; i++ {
+		g.Process("wait")
+	}
+	if g.Deaths == 0 {
+		t.Error("the cyclops should keep fighting until the player dies")
+	}
+}
+
+func TestFleeingEndsTheFight(t *testing.T) {
+	g := armedInTrollRoom(1)
+	troll := g.NPCs["troll"]
+	troll.Flags.IsFighting = true
+
+	g.Process("south")
+	if g.Location != "cellar" {
+		t.Fatalf("Location = %q, want cellar", g.Location)
+	}
+	if troll.Flags.IsFighting {
+		t.Error("the troll should stop fighting once the player has fled")
+	}
+}
+
+func TestDisarmedTrollRecoversAxe(t *testing.T) {
+	g := armedInTrollRoom(1)
+	troll := g.NPCs["troll"]
+	troll.Flags.IsFighting = true
+
+	g.applyHeroOutcome(troll, CombatLoseWeapon)
+	if g.Items["axe"].Location != "troll-room" {
+		t.Fatalf("axe Location = %q, want it knocked to the floor", g.Items["axe"].Location)
+	}
+
+	result := g.processFight()
+	if !strings.Contains(result, "recovers his weapon") || g.Items["axe"].Location != "" {
+		t.Errorf("the troll should pick his axe back up, got: %s", result)
+	}
+
+	// With the axe in the player's hands he gives up
+	g.applyHeroOutcome(troll, CombatLoseWeapon)
+	g.moveItem("axe", "inventory")
+	result = g.processFight()
+	if !strings.Contains(result, "cowers in terror") || troll.Flags.IsFighting {
+		t.Errorf("a disarmed troll should stop fighting, got: %s", result)
+	}
+}
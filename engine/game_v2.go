@@ -21,7 +21,20 @@ type GameV2 struct {
 	Flags     map[string]bool // Global game flags (WINDOW-OPEN, TROLL-DEAD, etc.)
 	GameOver  bool
 	Won       bool
+	Dead      bool           // Wandering as a spirit after dying (DEAD in ZIL)
+	Deaths    int            // Times the player has been brought back (DEATHS in ZIL)
+	Darkness  DarknessTracker // Whether the player is groping around in the dark
+	RiverTurns int            // Turns until the current carries the boat on (I-RIVER in ZIL)
+	MatchCount int            // Matches left in the matchbook (MATCH-COUNT in ZIL)
+	BoatState string          // Whether the boat is deflated, inflated or punctured
+	ReservoirTurns int        // Turns until the reservoir drains or fills (I-REMPTY and I-RFILL in ZIL)
+	WaterLevel int            // Flooding in the Maintenance Room, -1 once fixed (WATER-LEVEL in ZIL)
 	Version   string         // Game version injected at build time
+	Debug     bool           // Check world invariants after every command
+	ContinueAfterWin bool    // Keep playing after entering the barrow
+	Violation *InvariantViolation // First invariant violation seen in debug mode
+
+	itemContents map[string]*[]string // Container ID -> IDs of items inside (see containment.go)
 	rand      *rand.Rand     // Random number generator for thief AI
 }
 
@@ -31,6 +44,10 @@ type Player struct {
 	MaxWeight        int
 	Health           int
 	StrengthModifier int // ZIL P?STRENGTH modifier (reduced by wounds)
+	Staggered        bool // Next attack is wasted (STAGGERED in ZIL)
+	Weapon           string // Weapon last fought with
+	CureTurns        int  // Turns until the next wound heals (I-CURE in ZIL)
+	Vehicle          string // Item ID of the boat the player sits in, "" on foot
 }
 
 // NewGameV2 creates a new game with proper type separation
@@ -70,6 +87,10 @@ func (g *GameV2) initializeWorld() {
 
 	// Initialize game flags
 	g.Flags["GRUNLOCK"] = true // Grating starts unlocked (can be opened from either side)
+	g.MatchCount = matchesInBook
+
+	// Items are defined with just a Location; fill in the room and container lists
+	g.rebuildContainment()
 }
 
 func (g *GameV2) createNPCs() {
@@ -86,6 +107,7 @@ func (g *GameV2) createNPCs() {
 	troll.Hostile = true
 	troll.Flags.IsAggressive = true
 	troll.Flags.CanFight = true
+	troll.Action = trollAction
 	g.NPCs["troll"] = troll
 	g.Rooms["troll-room"].AddNPC("troll")
 
@@ -97,13 +119,14 @@ func (g *GameV2) createNPCs() {
 		"shady thief",
 		"There is a suspicious-looking individual, holding a large bag, leaning against one wall. He is armed with a deadly stiletto.",
 	)
-	thief.Location = "maze-1" // Starts in maze
+	thief.Location = "maze-1" // Starts in maze, visible until he first moves
 	thief.Strength = 5 // ZIL-faithful value - much stronger than troll!
 	thief.Weapon = "stiletto"
 	thief.Hostile = false // Only hostile if attacked or has loot
 	thief.Flags.IsAggressive = false
 	thief.Flags.CanFight = true
 	thief.Inventory = []string{} // Will steal treasures
+	thief.Action = thiefAction
 	g.NPCs["thief"] = thief
 	g.Rooms["maze-1"].AddNPC("thief")
 
@@ -120,6 +143,7 @@ func (g *GameV2) createNPCs() {
 	cyclops.Hostile = true
 	cyclops.Flags.IsAggressive = true
 	cyclops.Flags.CanFight = true
+	cyclops.Action = cyclopsAction
 	g.NPCs["cyclops"] = cyclops
 	g.Rooms["cyclops-room"].AddNPC("cyclops")
 
@@ -139,7 +163,7 @@ func (g *GameV2) createNPCs() {
 	g.NPCs["ghosts"] = ghosts
 	g.Rooms["entrance-to-hades"].AddNPC("ghosts")
 
-	// The Bat - Carries player to random dark rooms
+	// The Bat - Carries player off to the coal mine (see bat.go)
 	// ZIL: No STRENGTH property (1dungeon.zil:155) - special handler, not combatable
 	bat := NewNPC(
 		"bat",
@@ -175,12 +199,31 @@ func (g *GameV2) Process(input string) string {
 }
 
 func (g *GameV2) executeCommand(cmd *Command) string {
-	g.Moves++
+	if !isMetaCommand(cmd) {
+		g.Moves++
+	}
 
 	var result string
-
-	// Handle movement
-	if cmd.Verb == "walk" && cmd.Direction != "" {
+	handled := false
+
+	// A spirit can do very little (DEAD-FUNCTION in ZIL)
+	if g.Dead && cmd.Actor == "" {
+		result, handled = g.deadFunction(cmd)
+	} else if isBoat(g.vehicle()) && cmd.Actor == "" {
+		// So can a sailor (RBOAT-FUNCTION in ZIL)
+		result, handled = g.boatFunction(cmd)
+	} else if g.Location == "loud-room" && cmd.Actor == "" {
+		// And anyone deafened by the Loud Room (LOUD-ROOM-FCN in ZIL)
+		result, handled = g.loudRoomFunction(cmd)
+	}
+
+	if handled {
+		// Already answered by the spirit, the boat or the Loud Room
+	} else if cmd.Actor != "" {
+		// Commands addressed to an NPC go to the NPC first
+		result = g.handleActorCommand(cmd)
+	} else if cmd.Verb == "walk" && cmd.Direction != "" {
+		// Handle movement
 		result = g.handleMove(cmd.Direction)
 	} else {
 		// Handle other verbs
@@ -197,11 +240,18 @@ func (g *GameV2) executeCommand(cmd *Command) string {
 		case "examine":
 			result = g.handleExamine(cmd.DirectObject)
 		case "take":
-			result = g.handleTake(cmd.DirectObject)
+			// GET IN and GET OUT OF (V-BOARD and V-DISEMBARK in ZIL)
+			if cmd.DirectObject == "" && (cmd.Preposition == "in" || cmd.Preposition == "into") {
+				result = g.handleBoard(cmd.IndirectObject)
+			} else if cmd.DirectObject == "" && cmd.Preposition == "out" {
+				result = g.handleDisembark(cmd.IndirectObject)
+			} else {
+				result = g.handleTake(cmd.DirectObject)
+			}
 		case "drop":
 			result = g.handleDrop(cmd.DirectObject)
 		case "open":
-			result = g.handleOpen(cmd.DirectObject)
+			result = g.handleOpen(cmd.DirectObject, cmd.IndirectObject)
 		case "close":
 			result = g.handleClose(cmd.DirectObject)
 		case "unlock":
@@ -227,20 +277,22 @@ func (g *GameV2) executeCommand(cmd *Command) string {
 			}
 
 			if cmd.Preposition == "on" {
-				result = g.handleTurnOn(objName)
+				result = g.handleTurnOn(objName, "")
 			} else if cmd.Preposition == "off" {
 				result = g.handleTurnOff(objName)
+			} else if item := g.findItem(objName); item != nil && item.ID == "bolt" {
+				result = g.turnBolt(cmd.IndirectObject)
 			} else {
 				result = "Turn it on or off?"
 			}
 		case "turn-on":
 			// Multi-word verb "turn on"
-			result = g.handleTurnOn(cmd.DirectObject)
+			result = g.handleTurnOn(cmd.DirectObject, cmd.IndirectObject)
 		case "turn-off":
 			// Multi-word verb "turn off"
 			result = g.handleTurnOff(cmd.DirectObject)
 		case "light":
-			result = g.handleTurnOn(cmd.DirectObject)
+			result = g.handleTurnOn(cmd.DirectObject, cmd.IndirectObject)
 		case "extinguish":
 			result = g.handleTurnOff(cmd.DirectObject)
 		case "inventory":
@@ -255,7 +307,7 @@ func (g *GameV2) executeCommand(cmd *Command) string {
 		case "give":
 			result = g.handleGive(cmd.DirectObject, cmd.IndirectObject)
 		case "attack":
-			result = g.handleAttack(cmd.DirectObject)
+			result = g.handleAttack(cmd.DirectObject, cmd.IndirectObject)
 		case "wave":
 			result = g.handleWave(cmd.DirectObject)
 		case "climb":
@@ -318,11 +370,11 @@ func (g *GameV2) executeCommand(cmd *Command) string {
 		case "smell":
 			result = g.handleSmell(cmd.DirectObject)
 		case "touch":
-			result = g.handleTouch(cmd.DirectObject)
+			result = g.handleTouch(cmd.DirectObject, cmd.IndirectObject)
 		case "break":
-			result = g.handleBreak(cmd.DirectObject)
+			result = g.handleBreak(cmd.DirectObject, cmd.IndirectObject)
 		case "burn":
-			result = g.handleBurn(cmd.DirectObject)
+			result = g.handleBurn(cmd.DirectObject, cmd.IndirectObject)
 		case "search":
 			result = g.handleSearch(cmd.DirectObject)
 		case "jump":
@@ -356,22 +408,39 @@ func (g *GameV2) executeCommand(cmd *Command) string {
 			result = g.handleRestore(cmd)
 		case "score":
 			result = g.handleScore()
+			if breakdown := g.scoreBreakdown(); breakdown != "" {
+				result += "\n\n" + breakdown
+			}
 		case "restart":
 			result = "Restart is not yet implemented."
 		case "enter":
 			result = g.handleEnter(cmd)
 		case "exit", "leave":
-			result = g.handleMove("out")
+			if g.Player.Vehicle != "" {
+				result = g.handleDisembark("")
+			} else {
+				result = g.handleMove("out")
+			}
+		case "strike":
+			result = g.handleStrike(cmd.DirectObject)
+		case "count":
+			result = g.handleCount(cmd.DirectObject)
+		case "wind":
+			result = g.handleWind(cmd.DirectObject)
+		case "hatch":
+			result = g.handleHatch(cmd.DirectObject)
 		case "throw":
 			result = g.handleThrow(cmd)
 		case "kill":
-			result = g.handleAttack(cmd.DirectObject)
+			result = g.handleAttack(cmd.DirectObject, cmd.IndirectObject)
 		case "yell", "scream", "shout":
 			result = g.handleYell()
 		case "board":
 			result = g.handleBoard(cmd.DirectObject)
 		case "disembark":
-			result = g.handleMove("out")
+			result = g.handleDisembark(cmd.DirectObject)
+		case "launch":
+			result = g.handleLaunch(cmd.DirectObject)
 		case "brief":
 			result = "Brief mode is now on."
 		case "verbose":
@@ -431,236 +500,122 @@ func (g *GameV2) executeCommand(cmd *Command) string {
 		}
 	}
 
-	// Process NPC turns after every command (including grues!)
-	npcResult := g.processNPCTurns()
-	if npcResult != "" {
-		result += "\n\n" + npcResult
-	}
-
-	// Process lamp fuel depletion
-	lampResult := g.processLampFuel()
-	if lampResult != "" {
-		result += "\n\n" + lampResult
-	}
-
-	// Process candles fuel depletion
-	candlesResult := g.processCandlesFuel()
-	if candlesResult != "" {
-		result += "\n\n" + candlesResult
-	}
-
-	// Process thief behavior
-	thiefResult := g.processThiefTurn()
-	if thiefResult != "" {
-		result += "\n\n" + thiefResult
-	}
-
-	// Process sword glowing
-	swordResult := g.processSwordGlow()
-	if swordResult != "" {
-		result += "\n\n" + swordResult
+	// The room has the last word (M-END in ZIL)
+	if room := g.Rooms[g.Location]; room != nil && room.Action != nil {
+		if endResult := room.Action(g, EventEnd); endResult != "" {
+			result += "\n\n" + endResult
+		}
 	}
 
-	return result
-}
-
-// processNPCTurns handles NPC behaviors each turn
-func (g *GameV2) processNPCTurns() string {
-	var result strings.Builder
-
-	// Process grue attacks (must be first - can end the game!)
-	grueResult := g.processGrueBehavior()
-	if grueResult != "" {
-		result.WriteString(grueResult)
-		// If grue killed player, don't process other NPCs
-		if g.GameOver {
-			return strings.TrimSpace(result.String())
+	// Interface commands take no time: MAIN-LOOP doesn't run CLOCKER for them
+	if !isMetaCommand(cmd) {
+		// Process NPC turns after every command
+		npcResult := g.processNPCTurns()
+		if npcResult != "" {
+			result += "\n\n" + npcResult
 		}
-	}
 
-	// Process thief roaming and stealing
-	thiefResult := g.processThiefBehavior()
-	if thiefResult != "" {
-		if result.Len() > 0 {
-			result.WriteString("\n")
+		// Process lamp fuel depletion
+		lampResult := g.processLampFuel()
+		if lampResult != "" {
+			result += "\n\n" + lampResult
 		}
-		result.WriteString(thiefResult)
-	}
 
-	// Process bat behavior
-	batResult := g.processBatBehavior()
-	if batResult != "" {
-		if result.Len() > 0 {
-			result.WriteString("\n")
+		// Process candles fuel depletion
+		candlesResult := g.processCandlesFuel()
+		if candlesResult != "" {
+			result += "\n\n" + candlesResult
 		}
-		result.WriteString(batResult)
-	}
 
-	return strings.TrimSpace(result.String())
-}
+		// Let a struck match burn down (I-MATCH)
+		matchResult := g.processMatchFuel()
+		if matchResult != "" {
+			result += "\n\n" + matchResult
+		}
 
-// processThiefBehavior handles thief roaming and treasure stealing
-func (g *GameV2) processThiefBehavior() string {
-	thief := g.NPCs["thief"]
-	if thief == nil || !thief.Flags.IsAlive {
-		return ""
-	}
+		// Burn the fuse down (I-FUSE)
+		fuseResult := g.processFuse()
+		if fuseResult != "" {
+			result += "\n\n" + fuseResult
+		}
 
-	// Thief only acts every few turns (to not be too annoying)
-	if g.Moves%3 != 0 {
-		return ""
-	}
+		// Process thief behavior
+		thiefResult := g.processThiefTurn()
+		if thiefResult != "" {
+			result += "\n\n" + thiefResult
+		}
 
-	thiefRoom := g.Rooms[thief.Location]
-	if thiefRoom == nil {
-		return ""
-	}
+		// Heal wounds (I-CURE)
+		g.processCure()
 
-	// If thief is in same room as player, try to steal treasures
-	if thief.Location == g.Location {
-		// Look for treasures in room or player inventory
-		for _, itemID := range g.Player.Inventory {
-			item := g.Items[itemID]
-			if item != nil && item.Flags.IsTreasure {
-				// Thief steals the treasure!
-				// Remove from player inventory
-				for i, id := range g.Player.Inventory {
-					if id == itemID {
-						g.Player.Inventory = append(g.Player.Inventory[:i], g.Player.Inventory[i+1:]...)
-						break
-					}
-				}
-				// Add to thief's inventory
-				thief.Inventory = append(thief.Inventory, itemID)
-				item.Location = "thief-inventory"
-				return "The thief steals your " + item.Name + " and runs away laughing!"
-			}
+		// Carry the boat downstream (I-RIVER)
+		riverResult := g.processRiver()
+		if riverResult != "" {
+			result += "\n\n" + riverResult
 		}
-	}
 
-	// Thief moves to a random connected room
-	exits := thiefRoom.Exits
-	if len(exits) > 0 {
-		// Pick a random exit from the map
-		exitIndex := 0
-		targetIndex := g.Moves % len(exits)
-		var newLocation string
-		for _, exit := range exits {
-			if exitIndex == targetIndex {
-				newLocation = exit.To
-				break
-			}
-			exitIndex++
+		// Drain or fill the reservoir (I-REMPTY and I-RFILL)
+		reservoirResult := g.processReservoir()
+		if reservoirResult != "" {
+			result += "\n\n" + reservoirResult
 		}
 
-		if newLocation != "" {
-			// Move thief
-			thiefRoom.RemoveNPC("thief")
-			thief.Location = newLocation
-			newRoom := g.Rooms[newLocation]
-			if newRoom != nil {
-				newRoom.AddNPC("thief")
-			}
-
-			// If player can hear the thief, mention it
-			if thief.Location == g.Location {
-				return "The thief appears from the shadows!"
-			}
+		// Flood the Maintenance Room (I-MAINT-ROOM)
+		leakResult := g.processLeak()
+		if leakResult != "" {
+			result += "\n\n" + leakResult
 		}
-	}
-
-	return ""
-}
 
-// processBatBehavior handles bat grabbing and moving player
-func (g *GameV2) processBatBehavior() string {
-	bat := g.NPCs["bat"]
-	if bat == nil || !bat.Flags.IsAlive {
-		return ""
-	}
+		// Reveal the map once every treasure is cased
+		endgameResult := g.processEndgame()
+		if endgameResult != "" {
+			result += "\n\n" + endgameResult
+		}
 
-	// Bat only acts occasionally
-	if g.Moves%5 != 0 {
-		return ""
+		// Process sword glowing
+		swordResult := g.processSwordGlow()
+		if swordResult != "" {
+			result += "\n\n" + swordResult
+		}
 	}
 
-	// If bat is in same room as player, it might grab them
-	if bat.Location == g.Location {
-		// 50% chance to grab player (based on turn number)
-		if g.Moves%10 == 0 {
-			// Move player to a random adjacent room
-			currentRoom := g.Rooms[g.Location]
-			if currentRoom != nil && len(currentRoom.Exits) > 0 {
-				// Pick a random exit from the map
-				exitIndex := 0
-				targetIndex := g.Moves % len(currentRoom.Exits)
-				var newLocation string
-				for _, exit := range currentRoom.Exits {
-					if exitIndex == targetIndex {
-						newLocation = exit.To
-						break
-					}
-					exitIndex++
-				}
+	// Remember whether the turn ended in the dark
+	g.updateDarkness()
 
-				if newLocation != "" {
-					g.Location = newLocation
-					return "A large vampire bat swoops down, grabs you, and carries you off!\n\n" + g.handleLook()
-				}
-			}
-		}
+	// Verify the world model in debug mode
+	debugResult := g.checkInvariantsAfter(cmd)
+	if debugResult != "" {
+		result += "\n\n" + debugResult
 	}
 
-	return ""
+	return result
 }
 
-// processGrueBehavior handles grue attacks in the dark
-func (g *GameV2) processGrueBehavior() string {
-	// Check if player is in darkness without light
-	room := g.Rooms[g.Location]
-	if room == nil {
-		return ""
+// isMetaCommand reports whether a command only talks to the interface, so no
+// turn passes (the verbs MAIN-LOOP exempts from CLOCKER in ZIL)
+func isMetaCommand(cmd *Command) bool {
+	if cmd.Actor != "" {
+		return false
+	}
+	switch cmd.Verb {
+	case "save", "restore", "restart", "quit", "score", "version",
+		"brief", "verbose", "superbrief", "script", "unscript":
+		return true
 	}
+	return false
+}
 
-	// If room is dark and player has no light source
-	if room.Flags.IsDark && !g.hasLight() {
-		// Track consecutive turns in darkness
-		if !g.Flags["in-darkness"] {
-			// First turn in darkness - just a warning
-			g.Flags["in-darkness"] = true
-			g.Flags["darkness-turns"] = true // Using flag as counter start
-			return "" // Warning already shown by handleLook/handleMove
-		}
-
-		// Player has been in darkness for multiple turns
-		// In original Zork, grue attacks after 2-3 turns in darkness
-		// We'll use turn count modulo to create randomness
-		turnMod := g.Moves % 4
-		if turnMod == 0 {
-			// Grue attacks!
-			g.GameOver = true
-			return "\nOh, no! You have walked into the slavering fangs of a lurking grue!\n\n****  You have died  ****"
-		}
+// processNPCTurns handles NPC behaviors each turn
+func (g *GameV2) processNPCTurns() string {
+	var result strings.Builder
 
-		// Additional warnings
-		warningMod := g.Moves % 3
-		switch warningMod {
-		case 0:
-			return "You hear a horrible slavering sound in the darkness nearby..."
-		case 1:
-			return "The grue is getting closer! You can feel its hot, fetid breath..."
-		default:
-			return "Something is moving in the darkness. You should find light quickly!"
-		}
-	} else {
-		// Player has light or is in lit room - clear darkness tracking
-		if g.Flags["in-darkness"] {
-			g.Flags["in-darkness"] = false
-			delete(g.Flags, "darkness-turns")
-		}
+	// Villains in the room fight the player (I-FIGHT)
+	fightResult := g.processFight()
+	if fightResult != "" {
+		result.WriteString(fightResult)
 	}
 
-	return ""
+	return strings.TrimSpace(result.String())
 }
 
 // processLampFuel handles lamp fuel depletion each turn (I-LANTERN in ZIL)
@@ -688,10 +643,8 @@ func (g *GameV2) processLampFuel() string {
 		result := "The lamp has gone out."
 
 		// Check if player is now in darkness
-		room := g.Rooms[g.Location]
-		if room != nil && room.Flags.IsDark && !g.hasLight() {
-			result += "\n\nOh, no! You have walked into the slavering fangs of a lurking grue!\n\n****  You have died  ****"
-			g.GameOver = true
+		if g.inDarkness() {
+			result += "\nIt is now pitch black."
 		}
 
 		return result
@@ -725,10 +678,8 @@ func (g *GameV2) processCandlesFuel() string {
 		result := "You'd better have more light than from the candles."
 
 		// Check if player is now in darkness
-		room := g.Rooms[g.Location]
-		if room != nil && room.Flags.IsDark && !g.hasLight() {
-			result += "\n\nOh, no! You have walked into the slavering fangs of a lurking grue!\n\n****  You have died  ****"
-			g.GameOver = true
+		if g.inDarkness() {
+			result += "\nIt is now pitch black."
 		}
 
 		return result
@@ -737,158 +688,6 @@ func (g *GameV2) processCandlesFuel() string {
 	return ""
 }
 
-// processThiefTurn handles thief AI: movement, stealing, depositing treasures (I-THIEF in ZIL lines 3890-3931)
-func (g *GameV2) processThiefTurn() string {
-	thief := g.NPCs["thief"]
-	if thief == nil || !thief.Flags.IsAlive {
-		return ""
-	}
-
-	var result string
-
-	// Check if thief is in player's room
-	thiefInRoom := (thief.Location == g.Location)
-
-	// 1. If thief is at treasure-room and player is not there, deposit treasures
-	if thief.Location == "treasure-room" && !thiefInRoom {
-		// Deposit treasures silently
-		g.depositThiefTreasures()
-	}
-
-	// 2. If thief is in same room as player, handle interactions
-	if thiefInRoom && !g.GameOver {
-		// Random chance to steal from player or room
-		if g.randomChance(40) { // 40% chance to steal
-			stolen := g.thiefStealTreasures()
-			if stolen != "" {
-				result = stolen
-			}
-		}
-	}
-
-	// 3. Move thief to next room
-	g.moveThiefToNextRoom()
-
-	// 4. If thief ended up in player's room after moving, maybe reveal presence
-	if thief.Location == g.Location && g.randomChance(30) {
-		if len(thief.Inventory) > 0 {
-			result += "\nA seedy-looking individual with a large bag just wandered through the room."
-		}
-	}
-
-	return result
-}
-
-// moveThiefToNextRoom moves thief to a random adjacent room
-func (g *GameV2) moveThiefToNextRoom() {
-	thief := g.NPCs["thief"]
-	if thief == nil {
-		return
-	}
-
-	currentRoom := g.Rooms[thief.Location]
-	if currentRoom == nil {
-		return
-	}
-
-	// Get all possible exits
-	var possibleRooms []string
-	for _, exit := range currentRoom.Exits {
-		// Don't go to sacred rooms
-		targetRoom := g.Rooms[exit.To]
-		if targetRoom != nil {
-			possibleRooms = append(possibleRooms, exit.To)
-		}
-	}
-
-	// Pick a random room
-	if len(possibleRooms) > 0 {
-		newRoom := possibleRooms[g.randomInt(len(possibleRooms))]
-
-		// Remove from old room
-		oldRoom := g.Rooms[thief.Location]
-		if oldRoom != nil {
-			oldRoom.RemoveNPC("thief")
-		}
-
-		// Move to new room
-		thief.Location = newRoom
-		newRoomObj := g.Rooms[newRoom]
-		if newRoomObj != nil {
-			newRoomObj.AddNPC("thief")
-		}
-	}
-}
-
-// thiefStealTreasures attempts to steal treasures from room or player
-func (g *GameV2) thiefStealTreasures() string {
-	thief := g.NPCs["thief"]
-	if thief == nil {
-		return ""
-	}
-
-	var stolen []string
-
-	// Try to steal from room first
-	room := g.Rooms[g.Location]
-	if room != nil {
-		for _, itemID := range room.Contents {
-			item := g.Items[itemID]
-			if item != nil && item.Flags.IsTreasure && g.randomChance(75) {
-				// Steal it!
-				item.Location = "thief-inventory"
-				thief.Inventory = append(thief.Inventory, itemID)
-				room.RemoveItem(itemID)
-				stolen = append(stolen, item.Name)
-			}
-		}
-	}
-
-	// Try to steal from player
-	for i := len(g.Player.Inventory) - 1; i >= 0; i-- {
-		itemID := g.Player.Inventory[i]
-		item := g.Items[itemID]
-		if item != nil && item.Flags.IsTreasure && g.randomChance(50) {
-			// Steal it!
-			item.Location = "thief-inventory"
-			thief.Inventory = append(thief.Inventory, itemID)
-			g.Player.Inventory = append(g.Player.Inventory[:i], g.Player.Inventory[i+1:]...)
-			stolen = append(stolen, item.Name)
-		}
-	}
-
-	if len(stolen) > 0 {
-		return `A seedy-looking individual with a large bag just wandered through the room. On the way through, he quietly abstracted some valuables from your possession, mumbling something about "Doing unto others before..."`
-	}
-
-	return ""
-}
-
-// depositThiefTreasures moves treasures from thief to treasure-room
-func (g *GameV2) depositThiefTreasures() {
-	thief := g.NPCs["thief"]
-	if thief == nil {
-		return
-	}
-
-	treasureRoom := g.Rooms["treasure-room"]
-	if treasureRoom == nil {
-		return
-	}
-
-	// Move all treasures from thief to treasure-room
-	for _, itemID := range thief.Inventory {
-		item := g.Items[itemID]
-		if item != nil && item.Flags.IsTreasure {
-			item.Location = "treasure-room"
-			treasureRoom.AddItem(itemID)
-		}
-	}
-
-	// Clear thief inventory
-	thief.Inventory = []string{}
-}
-
 // randomChance returns true with given percentage probability
 func (g *GameV2) randomChance(percent int) bool {
 	return g.randomInt(100) < percent
@@ -978,19 +777,42 @@ func (g *GameV2) isRoomInfested(roomID string) bool {
 	return false
 }
 
+// diagonalExits maps the parser's diagonal directions to the exit names the
+// rooms use
+var diagonalExits = map[string]string{
+	"northeast": "ne",
+	"northwest": "nw",
+	"southeast": "se",
+	"southwest": "sw",
+}
+
 func (g *GameV2) handleMove(direction string) string {
 	currentRoom := g.Rooms[g.Location]
 	if currentRoom == nil {
 		return "You are nowhere!"
 	}
 
+	// Rooms name their diagonal exits by the short form
+	if short, ok := diagonalExits[direction]; ok {
+		direction = short
+	}
+
+	// Walking into the barrow ends the game (STONE-BARROW-FCN in ZIL)
+	if g.Location == "stone-barrow" && (direction == "west" || direction == "in") {
+		return g.enterBarrow()
+	}
+
 	exit := currentRoom.Exits[direction]
 	if exit == nil {
+		// Blundering about in the dark (V-WALK in ZIL)
+		if g.inDarkness() && g.grueAttacks() {
+			return g.jigsUp("Oh, no! You have walked into the slavering fangs of a lurking grue!")
+		}
 		return "You can't go that way."
 	}
 
-	// Check condition if present
-	if exit.Condition != "" && !g.Flags[exit.Condition] {
+	// Check condition if present. The troll ignores spirits (TROLL-FLAG in ZIL).
+	if exit.Condition != "" && !g.Flags[exit.Condition] && !(g.Dead && exit.Condition == "troll-dead") {
 		if exit.Message != "" {
 			return exit.Message
 		}
@@ -1003,17 +825,42 @@ func (g *GameV2) handleMove(direction string) string {
 		return "You can't go that way."
 	}
 
-	// Check if room is dark and player has no light
-	if destRoom.Flags.IsDark && !g.hasLight() {
-		return "It is pitch black. You are likely to be eaten by a grue."
+	// Water needs a boat, and the boat stays on the water
+	if msg := g.vehicleBlocks(exit.To); msg != "" {
+		return msg
+	}
+	if msg := g.damBlocks(exit.To); msg != "" {
+		return msg
 	}
 
 	// Move player
+	wasDark := g.Darkness.InDarkness
+	landed := g.rideVehicle(exit.To)
 	g.Location = exit.To
 	destRoom.FirstVisit = false
+	g.scoreRoom(exit.To)
+	g.Darkness.InDarkness = g.inDarkness()
+
+	// Moving from darkness into darkness feeds the grue (GOTO in ZIL)
+	if wasDark && g.Darkness.InDarkness && g.grueAttacks() {
+		return g.jigsUp("Oh, no! A lurking grue slithered into the room and devoured you!")
+	}
+
+	// The thief rushes to defend his lair
+	defends := ""
+	if g.Location == thiefLair {
+		defends = g.thiefDefendsLair()
+	}
+
+	// The bat carries off anyone not protected by garlic
+	if bat := g.NPCs["bat"]; bat != nil && g.Location == bat.Location {
+		if result := g.batGrabs(); result != "" {
+			return result
+		}
+	}
 
 	// Auto-look at new room
-	return g.handleLook()
+	return landed + defends + g.handleLook()
 }
 
 func (g *GameV2) handleLook() string {
@@ -1027,9 +874,19 @@ func (g *GameV2) handleLook() string {
 		return "It is pitch black. You are likely to be eaten by a grue."
 	}
 
+	description := room.Description
+	if room.Action != nil {
+		if d := room.Action(g, EventLook); d != "" {
+			description = d
+		}
+	}
+
 	var result strings.Builder
 	result.WriteString(room.Name + "\n")
-	result.WriteString(room.Description + "\n")
+	result.WriteString(description + "\n")
+	if aboard := g.vehicleDescription(); aboard != "" {
+		result.WriteString(aboard + "\n")
+	}
 
 	// List items in room
 	for _, itemID := range room.Contents {
@@ -1040,6 +897,11 @@ func (g *GameV2) handleLook() string {
 			continue // Skip trap door in living-room if rug hasn't been moved
 		}
 
+		// The player's own vehicle was described above
+		if itemID == g.Player.Vehicle {
+			continue
+		}
+
 		if item != nil && !item.Flags.IsInvisible && !item.Flags.NoRoomListing {
 			// Use RoomDescription (FDESC) if available, otherwise generic description
 			if item.RoomDescription != "" {
@@ -1053,8 +915,12 @@ func (g *GameV2) handleLook() string {
 	// List NPCs in room
 	for _, npcID := range room.NPCs {
 		npc := g.NPCs[npcID]
-		if npc != nil {
-			if npc.Flags.IsAlive {
+		if npc != nil && !npc.Flags.IsInvisible {
+			if npc.Flags.IsUnconscious {
+				result.WriteString(unconsciousDescription(npc) + "\n")
+			} else if npc.ID == "bat" {
+				result.WriteString(g.batDescription() + "\n")
+			} else if npc.Flags.IsAlive {
 				result.WriteString(npc.Description + "\n")
 			} else {
 				// Show corpse description for dead NPCs
@@ -1082,6 +948,14 @@ func (g *GameV2) handleExamine(objName string) string {
 			return "There is an ugly person staring back at you."
 		}
 
+		// Special case: examining the matchbook (MATCH-FUNCTION in ZIL)
+		if item.ID == "match" {
+			if item.Flags.IsLit {
+				return "The match is burning."
+			}
+			return "The matchbook isn't very interesting, except for what's written on it."
+		}
+
 		// Special case: examining sword (SWORD-FCN in ZIL lines 2432-2442)
 		if item.ID == "sword" {
 			result := item.Description
@@ -1136,10 +1010,8 @@ func (g *GameV2) handleExamine(objName string) string {
 			// Show what's inside if open or transparent
 			if item.Flags.IsOpen || item.Flags.IsTransparent {
 				var contents []string
-				for _, otherItem := range g.Items {
-					if otherItem.Location == item.ID {
-						contents = append(contents, otherItem.Name)
-					}
+				for _, id := range g.contentsOf(item.ID) {
+					contents = append(contents, g.Items[id].Name)
 				}
 
 				if len(contents) > 0 {
@@ -1190,21 +1062,25 @@ func (g *GameV2) handleTake(objName string) string {
 		return "You can't take the " + item.Name + "."
 	}
 
+	// PRE-TAKE in ZIL
+	if item.Location == "inventory" {
+		return "You already have that!"
+	}
+
+	// ITAKE in ZIL: anything not already in something the player carries
+	// adds to the load
+	if !g.isInside(item.ID, "inventory") && g.carriedWeight()+g.weightOf(item.ID) > g.loadAllowed() {
+		return g.tooHeavyMessage()
+	}
+
 	// Special case: Taking the rug reveals the trap door
 	if item.ID == "rug" && g.Location == "living-room" {
 		g.Flags["trap-door-open"] = true
 		// Trap door is already in the room (global object), just needs to be revealed
 	}
 
-	// Remove from room
-	room := g.Rooms[g.Location]
-	if room != nil {
-		room.RemoveItem(item.ID)
-	}
-
-	// Add to inventory
-	item.Location = "inventory"
-	g.Player.Inventory = append(g.Player.Inventory, item.ID)
+	g.moveItem(item.ID, "inventory")
+	g.scoreTake(item)
 
 	if item.ID == "rug" && g.Location == "living-room" {
 		return "Taken.\nWith the rug moved aside, you can see a closed trap door beneath it!"
@@ -1219,20 +1095,7 @@ func (g *GameV2) handleDrop(objName string) string {
 		return "You don't have that."
 	}
 
-	// Remove from inventory
-	for i, id := range g.Player.Inventory {
-		if id == item.ID {
-			g.Player.Inventory = append(g.Player.Inventory[:i], g.Player.Inventory[i+1:]...)
-			break
-		}
-	}
-
-	// Add to current room
-	item.Location = g.Location
-	room := g.Rooms[g.Location]
-	if room != nil {
-		room.AddItem(item.ID)
-	}
+	g.moveItem(item.ID, g.Location)
 
 	return "Dropped."
 }
@@ -1253,7 +1116,7 @@ func (g *GameV2) handleInventory() string {
 	return strings.TrimSpace(result.String())
 }
 
-func (g *GameV2) handleOpen(objName string) string {
+func (g *GameV2) handleOpen(objName, toolName string) string {
 	if objName == "" {
 		return "What do you want to open?"
 	}
@@ -1273,6 +1136,16 @@ func (g *GameV2) handleOpen(objName string) string {
 		return "The book is already open to page 569."
 	}
 
+	// Opening the matchbook shows what is left (MATCH-FUNCTION in ZIL)
+	if item.ID == "match" {
+		return g.countMatches()
+	}
+
+	// The egg is too delicate for the player to open (EGG-OBJECT in ZIL)
+	if item.ID == "egg" {
+		return g.openEgg(item, toolName, false)
+	}
+
 	// Special handling for kitchen window (KITCHEN-WINDOW-F in ZIL lines 242-246)
 	if item.ID == "kitchen-window" {
 		if g.Flags["window-open"] {
@@ -1336,14 +1209,7 @@ func (g *GameV2) handleOpen(objName string) string {
 			g.Flags["grate-revealed"] = true
 
 			// Add leaves to the room if they exist
-			leaves := g.Items["pile-of-leaves"]
-			if leaves != nil {
-				leaves.Location = g.Location
-				room := g.Rooms[g.Location]
-				if room != nil {
-					room.AddItem("pile-of-leaves")
-				}
-			}
+			g.moveItem("pile-of-leaves", g.Location)
 		}
 
 		return result
@@ -1566,10 +1432,8 @@ func (g *GameV2) handleLookIn(objName string) string {
 
 	// Find items inside this container
 	var contents []string
-	for _, otherItem := range g.Items {
-		if otherItem.Location == item.ID {
-			contents = append(contents, otherItem.Name)
-		}
+	for _, id := range g.contentsOf(item.ID) {
+		contents = append(contents, g.Items[id].Name)
 	}
 
 	if len(contents) == 0 {
@@ -1584,7 +1448,7 @@ func (g *GameV2) handleLookIn(objName string) string {
 	return strings.TrimSpace(result.String())
 }
 
-func (g *GameV2) handleTurnOn(objName string) string {
+func (g *GameV2) handleTurnOn(objName, toolName string) string {
 	if objName == "" {
 		return "What do you want to turn on?"
 	}
@@ -1594,21 +1458,46 @@ func (g *GameV2) handleTurnOn(objName string) string {
 		return "You can't see any " + objName + " here."
 	}
 
+	if item.ID == "fuse" {
+		return g.lightFuse(toolName)
+	}
+
 	if !item.Flags.IsLightSource {
 		return "You can't turn that on."
 	}
 
+	// Matches must be struck, and candles need a flame (MATCH-FUNCTION and
+	// CANDLES-FCN in ZIL)
+	flame := ""
+	switch item.ID {
+	case "match":
+		return g.strikeMatch()
+	case "candles":
+		msg, ok := g.candleFlame(toolName)
+		if !ok {
+			return msg
+		}
+		flame = msg
+	}
+
 	if item.Flags.IsLit {
 		return "It's already on."
 	}
 
 	item.Flags.IsLit = true
 
-	// Special case: lighting candles during bell ceremony (LLD-ROOM M-END in ZIL lines 1115-1125)
-	if item.ID == "candles" && g.Location == "entrance-to-hades" && g.Flags["XB"] && !g.Flags["XC"] && !g.Flags["LLD-FLAG"] {
-		g.Flags["XC"] = true
-		g.Flags["candles-ceremony-active"] = true
-		return `The flames flicker wildly and appear to dance. The earth beneath your feet trembles, and your legs nearly buckle beneath you. The spirits cower at your unearthly power.`
+	if result := g.gasIgnites(item); result != "" {
+		return flame + result
+	}
+
+	if item.ID == "candles" {
+		// Special case: lighting candles during bell ceremony (LLD-ROOM M-END in ZIL lines 1115-1125)
+		if g.Location == "entrance-to-hades" && g.Flags["XB"] && !g.Flags["XC"] && !g.Flags["LLD-FLAG"] {
+			g.Flags["XC"] = true
+			g.Flags["candles-ceremony-active"] = true
+			return flame + "The candles are lit.\n\n" + `The flames flicker wildly and appear to dance. The earth beneath your feet trembles, and your legs nearly buckle beneath you. The spirits cower at your unearthly power.`
+		}
+		return flame + "The candles are lit."
 	}
 
 	return "The " + item.Name + " is now on."
@@ -1628,6 +1517,10 @@ func (g *GameV2) handleTurnOff(objName string) string {
 		return "You can't turn that off."
 	}
 
+	if item.ID == "match" {
+		return g.putOutMatch()
+	}
+
 	if !item.Flags.IsLit {
 		return "It's already off."
 	}
@@ -1682,8 +1575,8 @@ func (g *GameV2) findItem(name string) *Item {
 
 			// Check inside containers in the room
 			if item != nil && item.Flags.IsContainer && (item.Flags.IsOpen || item.Flags.IsTransparent) {
-				for _, otherItem := range g.Items {
-					if otherItem.Location == item.ID && otherItem.HasAlias(name) {
+				for _, id := range g.contentsOf(item.ID) {
+					if otherItem := g.Items[id]; otherItem.HasAlias(name) {
 						return otherItem
 					}
 				}
@@ -1701,8 +1594,8 @@ func (g *GameV2) findItem(name string) *Item {
 	for _, itemID := range g.Player.Inventory {
 		item := g.Items[itemID]
 		if item != nil && item.Flags.IsContainer && (item.Flags.IsOpen || item.Flags.IsTransparent) {
-			for _, otherItem := range g.Items {
-				if otherItem.Location == item.ID && otherItem.HasAlias(name) {
+			for _, id := range g.contentsOf(item.ID) {
+				if otherItem := g.Items[id]; otherItem.HasAlias(name) {
 					return otherItem
 				}
 			}
@@ -1739,7 +1632,7 @@ func (g *GameV2) findNPC(name string) *NPC {
 
 	for _, npcID := range room.NPCs {
 		npc := g.NPCs[npcID]
-		if npc != nil && (npc.ID == name || npc.Name == name || strings.Contains(npc.Name, name)) {
+		if npc != nil && !npc.Flags.IsInvisible && (npc.ID == name || npc.Name == name || strings.Contains(npc.Name, name)) {
 			return npc
 		}
 	}
@@ -1747,6 +1640,11 @@ func (g *GameV2) findNPC(name string) *NPC {
 }
 
 func (g *GameV2) hasLight() bool {
+	// Spirits see without light (ALWAYS-LIT in ZIL)
+	if g.Dead {
+		return true
+	}
+
 	// Check if current room is lit
 	room := g.Rooms[g.Location]
 	if room != nil && room.Flags.IsLit {
@@ -1773,6 +1671,11 @@ func (g *GameV2) handlePut(objName string, prep string, containerName string) st
 		return "Where do you want to put it?"
 	}
 
+	// Putty on the leak plugs it (LEAK-FUNCTION in ZIL)
+	if target := g.findItem(containerName); target != nil && target.ID == "leak" {
+		return g.handlePlug(containerName, objName)
+	}
+
 	// Find the item in inventory
 	item := g.findItemInInventory(objName)
 	if item == nil {
@@ -1793,26 +1696,22 @@ func (g *GameV2) handlePut(objName string, prep string, containerName string) st
 		return "The " + container.Name + " is closed."
 	}
 
-	// Remove from inventory
-	for i, id := range g.Player.Inventory {
-		if id == item.ID {
-			g.Player.Inventory = append(g.Player.Inventory[:i], g.Player.Inventory[i+1:]...)
-			break
+	// Nothing can go inside itself (ZIL V-PUT: PRSO = PRSI or PRSI in PRSO)
+	for loc := container; loc != nil; loc = g.Items[loc.Location] {
+		if loc.ID == item.ID {
+			return "How can you do that?"
 		}
 	}
 
-	// Add to container
-	item.Location = container.ID
+	if !g.containerHasRoom(container, item.ID) {
+		return "There's no room."
+	}
+
+	g.moveItem(item.ID, container.ID)
 
 	// Special case: Putting treasure in trophy case awards points
-	if container.ID == "trophy-case" && item.Flags.IsTreasure {
-		// Check if this treasure has already been scored
-		scoreFlag := "scored-" + item.ID
-		if !g.Flags[scoreFlag] {
-			g.Flags[scoreFlag] = true
-			g.Score += item.Value
-			return fmt.Sprintf("Done. (%d points awarded)", item.Value)
-		}
+	if container.ID == "trophy-case" && g.scoreCase(item) {
+		return fmt.Sprintf("Done. (%d points awarded)", item.Value)
 	}
 
 	return "Done."
@@ -1837,14 +1736,6 @@ func (g *GameV2) handleGive(objName string, npcName string) string {
 		return "There is no " + npcName + " here."
 	}
 
-	// Remove from inventory first
-	for i, id := range g.Player.Inventory {
-		if id == item.ID {
-			g.Player.Inventory = append(g.Player.Inventory[:i], g.Player.Inventory[i+1:]...)
-			break
-		}
-	}
-
 	// Special cases per NPC
 	switch npc.ID {
 	case "troll":
@@ -1852,32 +1743,20 @@ func (g *GameV2) handleGive(objName string, npcName string) string {
 		if item.Flags.IsTreasure {
 			// Troll is bribed and leaves
 			g.Flags["troll-dead"] = true // Opens passages (same flag as when killed)
-			room := g.Rooms[npc.Location]
-
-			// Troll drops his axe
-			axe := g.Items["axe"]
-			if axe != nil {
-				axe.Location = npc.Location
-				if room != nil {
-					room.AddItem("axe")
-				}
-			}
 
-			// Remove troll from room
-			if room != nil {
-				room.RemoveNPC("troll")
-			}
-			npc.Location = "" // Troll leaves the dungeon
+			// Troll drops his axe and leaves the dungeon
+			g.moveItem("axe", npc.Location)
+			g.moveNPC(npc, "")
 
 			// Treasure is consumed (troll eats it)
-			delete(g.Items, item.ID)
+			g.removeItem(item.ID)
 
 			return "The troll, who is not overly proud, graciously accepts the gift and not having the most discriminating tastes, gleefully eats it.\n\nThe troll, satiated, contentedly waddles off into the darkness, his axe clattering to the floor. The passages are now open."
 		}
 
 		// Non-treasure items
 		// In ZIL, troll accepts anything but only leaves for treasures
-		delete(g.Items, item.ID) // Troll eats it anyway
+		g.removeItem(item.ID) // Troll eats it anyway
 		return "The troll, who is not overly proud, graciously accepts the gift and not having the most discriminating tastes, gleefully eats it.\n\nHowever, the troll is still blocking the passages."
 
 	case "cyclops":
@@ -1885,22 +1764,17 @@ func (g *GameV2) handleGive(objName string, npcName string) string {
 		// Two-part puzzle: 1) give lunch (hot peppers) 2) give water to put him to sleep
 		if item.ID == "lunch" {
 			// Give hot peppers - makes cyclops thirsty but doesn't solve puzzle
-			delete(g.Items, item.ID)
+			g.removeItem(item.ID)
 			return "The cyclops says \"Mmm Mmm. I love hot peppers! But oh, could I use a drink. Perhaps I could drink the blood of that thing.\" From the gleam in his eye, it could be surmised that you are \"that thing\"."
 		}
 		if item.ID == "water" || (item.ID == "bottle" && g.Items["water"] != nil && g.Items["water"].Location == "bottle") {
 			// Give water - cyclops drinks and falls asleep, sets CYCLOPS-FLAG
 			// Only works after giving hot peppers (not checking in this simplified version)
-			delete(g.Items, "water")
+			g.removeItem("water")
 			// Put empty bottle back in room
-			bottle := g.Items["bottle"]
-			if bottle != nil {
-				bottle.Location = g.Location
+			if bottle := g.Items["bottle"]; bottle != nil {
+				g.moveItem("bottle", g.Location)
 				bottle.Flags.IsOpen = true
-				room := g.Rooms[g.Location]
-				if room != nil {
-					room.AddItem("bottle")
-				}
 			}
 			// Cyclops falls asleep
 			g.Flags["cyclops-flag"] = true
@@ -1917,22 +1791,21 @@ func (g *GameV2) handleGive(objName string, npcName string) string {
 	case "thief":
 		// Thief steals valuable items
 		if item.Flags.IsTreasure {
-			npc.Inventory = append(npc.Inventory, item.ID)
-			item.Location = "thief-inventory"
+			g.moveItem(item.ID, npc.ID)
 			return "The thief snatches the " + item.Name + " and runs off with a wicked grin!"
 		}
 	}
 
 	// Give item to NPC (add to their inventory)
-	npc.Inventory = append(npc.Inventory, item.ID)
-	item.Location = npc.ID
+	g.moveItem(item.ID, npc.ID)
 
 	return "The " + npc.Name + " accepts the " + item.Name + " reluctantly."
 }
 
 // handleAttack attacks an NPC or object (V-ATTACK in ZIL)
 // handleAttack implements ZIL-faithful combat (V-ATTACK, gverbs.zil:176-190)
-func (g *GameV2) handleAttack(objName string) string {
+// Without "with", the player fights with the weapon already in hand.
+func (g *GameV2) handleAttack(objName, weaponName string) string {
 	if objName == "" {
 		return "Attack what?"
 	}
@@ -1942,12 +1815,19 @@ func (g *GameV2) handleAttack(objName string) string {
 	if npc == nil {
 		// Check for item
 		item := g.findItem(objName)
+		if isMirror(item) {
+			return g.breakMirror()
+		}
 		if item != nil {
 			return "I've known strange people, but fighting a " + objName + "?"
 		}
 		return "You can't see any " + objName + " here."
 	}
 
+	if npc.ID == "bat" {
+		return g.batAttacked()
+	}
+
 	if !npc.Flags.CanFight {
 		return "You can't attack the " + npc.Name + "."
 	}
@@ -1956,14 +1836,32 @@ func (g *GameV2) handleAttack(objName string) string {
 		return "The " + npc.Name + " is already dead."
 	}
 
+	// Pick the weapon (ZIL: PRSI, or the one found in the player's hands)
+	var playerWeapon *Item
+	if weaponName != "" && weaponName != "hands" {
+		playerWeapon = g.findItem(weaponName)
+		if playerWeapon == nil {
+			return "You can't see any " + weaponName + " here."
+		}
+	} else if weaponName == "" {
+		playerWeapon = g.wieldedWeapon()
+	}
+
 	// Check if player has a weapon (ZIL: can't use bare hands)
-	playerWeapon := g.findPlayerWeapon()
 	if playerWeapon == nil {
 		return fmt.Sprintf("Trying to attack a %s with your bare hands is suicidal.", npc.Name)
 	}
 
 	// Check if player is holding the weapon (ZIL: IN? PRSI WINNER)
-	// (This is already guaranteed by findPlayerWeapon, but kept for ZIL fidelity)
+	if g.parentOf(playerWeapon.ID) != "inventory" {
+		return "You aren't even holding the " + playerWeapon.Name + "."
+	}
+
+	// Check that it is a weapon (ZIL: FSET? PRSI WEAPONBIT)
+	if !playerWeapon.Flags.IsWeapon {
+		return fmt.Sprintf("Trying to attack the %s with a %s is suicidal.", npc.Name, playerWeapon.Name)
+	}
+	g.Player.Weapon = playerWeapon.ID
 
 	// ZIL-faithful combat system (HERO-BLOW)
 	var result strings.Builder
@@ -1976,26 +1874,17 @@ func (g *GameV2) handleAttack(objName string) string {
 	// Apply outcome to NPC
 	g.applyHeroOutcome(npc, outcome)
 
+	// The villain fights back from now on; his blows come from the fight
+	// daemon (FIGHTBIT in ZIL)
+	npc.Hostile = true
+	npc.Flags.IsFighting = true
+
 	// Check if NPC died
 	if !npc.Flags.IsAlive {
 		result.WriteString(g.handleNPCDeath(npc))
 		return strings.TrimSpace(result.String())
 	}
 
-	// NPC counter-attacks (VILLAIN-BLOW routine)
-	result.WriteString("\n")
-	villainOutcome, villainMessage := g.villainBlow(npc)
-	result.WriteString(villainMessage + "\n")
-
-	// Apply outcome to player
-	g.applyVillainOutcome(villainOutcome)
-
-	// Check if player died
-	if g.Player.Health <= 0 {
-		g.GameOver = true
-		result.WriteString("\n    ****  You have died  ****\n")
-	}
-
 	return strings.TrimSpace(result.String())
 }
 
@@ -2007,21 +1896,12 @@ func (g *GameV2) handleNPCDeath(npc *NPC) string {
 	case "troll":
 		// Troll drops axe and vanishes (TROLL-FCN F-DEAD in ZIL)
 		g.Flags["troll-dead"] = true
-		room := g.Rooms[npc.Location]
 
 		// Troll drops his axe
-		axe := g.Items["axe"]
-		if axe != nil {
-			axe.Location = npc.Location
-			if room != nil {
-				room.AddItem("axe")
-			}
-		}
+		g.moveItem("axe", npc.Location)
 
 		// Remove troll from room (special case - body vanishes)
-		if room != nil {
-			room.RemoveNPC("troll")
-		}
+		g.moveNPC(npc, "")
 		result.WriteString("Almost as soon as the troll breathes his last breath, a cloud of sinister black fog envelops him, and when the fog lifts, the carcass has disappeared.\n\nThe troll's axe clatters to the floor.")
 
 	case "cyclops":
@@ -2032,34 +1912,17 @@ func (g *GameV2) handleNPCDeath(npc *NPC) string {
 		// This will essentially never happen in ZIL-faithful combat
 		// But we'll keep the handler for consistency
 
-		// Add treasure to room
-		if treasure := g.Items["cyclops-treasure"]; treasure != nil {
-			treasure.Location = npc.Location
-			if room := g.Rooms[npc.Location]; room != nil {
-				room.AddItem("cyclops-treasure")
-			}
-		}
-		// Remove cyclops from room
-		room := g.Rooms[npc.Location]
-		if room != nil {
-			room.RemoveNPC("cyclops")
-		}
+		// Add treasure to room, then remove cyclops from room
+		g.moveItem("cyclops-treasure", npc.Location)
+		g.moveNPC(npc, "")
 		result.WriteString("The cyclops falls with a thunderous crash. His treasures are now yours!")
 
 	case "thief":
 		// Thief is killed - implement DEPOSIT-BOOTY (ZIL 1actions.zil:2035-2062)
 		g.Flags["thief-dead"] = true
-		room := g.Rooms[npc.Location]
 
 		// Drop stiletto to room (ZIL line 2036)
-		stiletto := g.Items["stiletto"]
-		if stiletto != nil {
-			stiletto.Location = npc.Location
-			if room != nil {
-				room.AddItem("stiletto")
-			}
-			npc.Inventory = removeFromSlice(npc.Inventory, "stiletto")
-		}
+		g.moveItem("stiletto", npc.Location)
 
 		// DEPOSIT-BOOTY: Drop all stolen treasures (ZIL line 2038, routine at 1897-1913)
 		var droppedTreasures []string
@@ -2067,37 +1930,31 @@ func (g *GameV2) handleNPCDeath(npc *NPC) string {
 			item := g.Items[itemID]
 			// Skip large-bag, drop all treasures (Value > 0)
 			if item != nil && itemID != "large-bag" && item.Value > 0 {
-				item.Location = npc.Location
-				if room != nil {
-					room.AddItem(itemID)
-				}
+				g.moveItem(itemID, npc.Location)
 				droppedTreasures = append(droppedTreasures, itemID)
 
 				// Special case: egg opens when dropped (ZIL line 2009)
 				if itemID == "egg" {
-					g.Flags["egg-solve"] = true
-					item.Flags.IsOpen = true
+					g.solveEgg()
 				}
 			}
 		}
 
-		// Remove dropped treasures from thief's inventory
-		for _, itemID := range droppedTreasures {
-			npc.Inventory = removeFromSlice(npc.Inventory, itemID)
+		// If in treasure room, reveal the treasures he hid (ZIL lines 2039-2059)
+		var hidden []string
+		if npc.Location == thiefLair {
+			for _, itemID := range g.contentsOf(thiefLair) {
+				if item := g.Items[itemID]; item.Flags.IsInvisible || containsString(droppedTreasures, itemID) {
+					hidden = append(hidden, itemID)
+				}
+			}
 		}
-
-		// If in treasure room, reveal invisible treasures (ZIL lines 2039-2059)
-		if npc.Location == "treasure-room" && len(droppedTreasures) > 0 {
+		if len(hidden) > 0 {
 			result.WriteString("As the thief dies, the power of his magic decreases, and his treasures reappear:\n")
-			for _, itemID := range droppedTreasures {
+			for _, itemID := range hidden {
 				item := g.Items[itemID]
-				if item != nil {
-					result.WriteString(fmt.Sprintf("  A %s\n", item.Name))
-					// Make visible if it was invisible
-					if item.Flags.IsInvisible {
-						item.Flags.IsInvisible = false
-					}
-				}
+				result.WriteString(fmt.Sprintf("  A %s\n", item.Name))
+				item.Flags.IsInvisible = false
 			}
 		} else if len(droppedTreasures) > 0 {
 			// Not in treasure room - just say booty remains
@@ -2107,9 +1964,7 @@ func (g *GameV2) handleNPCDeath(npc *NPC) string {
 			result.WriteString("The thief, his last breath gurgling in his throat, falls to the ground.")
 		}
 
-		// Disable thief AI (ZIL line 2062)
-		// Note: Thief AI daemon will be disabled when we implement I-THIEF interval
-		// For now, setting thief-dead flag is sufficient
+		// Thief AI stops with him (ZIL line 2062): processThiefTurn skips a dead thief
 
 		// DON'T remove thief from room - corpse remains (unlike troll)
 		// This matches ZIL behavior
@@ -2168,8 +2023,7 @@ func (g *GameV2) handleWave(objName string) string {
 		// On the rainbow itself - DEADLY!
 		if g.Location == "on-rainbow" {
 			g.Flags["rainbow-flag"] = false
-			g.GameOver = true
-			return "The structural integrity of the rainbow is severely compromised, leaving you hanging in midair, supported only by water vapor. Bye.\n\n****  You have died  ****"
+			return g.jigsUp("The structural integrity of the rainbow is severely compromised, leaving you hanging in midair, supported only by water vapor. Bye.")
 		}
 
 		// Anywhere else
@@ -2200,6 +2054,11 @@ func (g *GameV2) handleClimb(objName string) string {
 		return "The ladder is lying on the ground. You can't climb it."
 	}
 
+	// Sitting on the egg is no way to hatch it (EGG-OBJECT in ZIL)
+	if item.ID == "egg" {
+		return g.crushEgg(false)
+	}
+
 	return "You can't climb that."
 }
 
@@ -2265,31 +2124,7 @@ func (g *GameV2) handlePush(objName string) string {
 	if strings.Contains(item.ID, "button") {
 		// Dam control buttons
 		if g.Location == "maintenance-room" {
-			switch item.ID {
-			case "yellow-button":
-				// Yellow button opens the dam gates - drains reservoir
-				if g.Flags["dam-open"] {
-					return "Click. The gates are already open."
-				}
-				g.Flags["dam-open"] = true
-				g.Flags["low-tide"] = true
-				return "Click. You hear a rumbling sound in the distance as the dam gates open and water drains from the reservoir."
-
-			case "blue-button":
-				// Blue button closes the dam gates - fills reservoir
-				if !g.Flags["dam-open"] {
-					return "Click. The gates are already closed."
-				}
-				g.Flags["dam-open"] = false
-				g.Flags["low-tide"] = false
-				return "Click. You hear a rushing sound as the dam gates close and water begins to fill the reservoir."
-
-			case "brown-button":
-				return "Click. Nothing seems to happen."
-
-			case "red-button":
-				return "Click. Nothing seems to happen."
-			}
+			return g.pushDamButton(item)
 		}
 
 		// Machine control buttons
@@ -2301,26 +2136,9 @@ func (g *GameV2) handlePush(objName string) string {
 					return "Click. The basket is already at the bottom."
 				}
 
-				// Transfer basket and its contents
-				shaftRoom := g.Rooms["shaft-room"]
-				lowerShaft := g.Rooms["lower-shaft"]
-
-				// Remove raised basket from shaft-room
-				shaftRoom.RemoveItem("raised-basket")
-
-				// Move all items from raised-basket to lowered-basket
-				for _, otherItem := range g.Items {
-					if otherItem.Location == "raised-basket" {
-						otherItem.Location = "lowered-basket"
-					}
-				}
-
-				// Add lowered basket to lower-shaft
-				loweredBasket := g.Items["lowered-basket"]
-				loweredBasket.Location = "lower-shaft"
-				lowerShaft.AddItem("lowered-basket")
+				g.moveVehicle("basket", "lower-shaft")
 
-				g.Flags["basket-lowered"] = true
+				g.setBasketLowered(true)
 				return "Click. You hear a whirring sound as the basket descends."
 
 			case "start-button":
@@ -2329,26 +2147,9 @@ func (g *GameV2) handlePush(objName string) string {
 					return "Click. The basket is already at the top."
 				}
 
-				// Transfer basket and its contents
-				shaftRoom := g.Rooms["shaft-room"]
-				lowerShaft := g.Rooms["lower-shaft"]
-
-				// Remove lowered basket from lower-shaft
-				lowerShaft.RemoveItem("lowered-basket")
-
-				// Move all items from lowered-basket to raised-basket
-				for _, otherItem := range g.Items {
-					if otherItem.Location == "lowered-basket" {
-						otherItem.Location = "raised-basket"
-					}
-				}
-
-				// Add raised basket back to shaft-room
-				raisedBasket := g.Items["raised-basket"]
-				raisedBasket.Location = "shaft-room"
-				shaftRoom.AddItem("raised-basket")
+				g.moveVehicle("basket", "shaft-room")
 
-				g.Flags["basket-lowered"] = false
+				g.setBasketLowered(false)
 				return "Click. You hear a whirring sound as the basket ascends."
 
 			case "launch-button":
@@ -2400,6 +2201,42 @@ func (g *GameV2) handleMoveObject(objName string) string {
 	return "Moving the " + item.Name + " doesn't seem to help."
 }
 
+// handleStrike strikes something, which for a match means lighting it
+// (V-STRIKE in ZIL)
+func (g *GameV2) handleStrike(objName string) string {
+	if objName == "" {
+		return "Strike what?"
+	}
+
+	if npc := g.findNPC(objName); npc != nil {
+		return "Since you aren't versed in hand-to-hand combat, you'd better attack the " + npc.Name + " with a weapon."
+	}
+	return g.handleTurnOn(objName, "")
+}
+
+// handleCount counts something (V-COUNT in ZIL)
+func (g *GameV2) handleCount(objName string) string {
+	if objName == "" {
+		return "Count what?"
+	}
+
+	item := g.findItem(objName)
+	if item == nil {
+		if objName == "blessings" {
+			return "Well, for one, you are playing Zork..."
+		}
+		return "You can't see any " + objName + " here."
+	}
+
+	switch item.ID {
+	case "match":
+		return g.countMatches()
+	case "candles":
+		return "Let's see, how many objects in a pair? Don't tell me, I'll get it."
+	}
+	return "You have lost your mind."
+}
+
 // handleRing rings something (V-RING in ZIL)
 func (g *GameV2) handleRing(objName string) string {
 	if objName == "" {
@@ -2421,18 +2258,7 @@ func (g *GameV2) handleRing(objName string) string {
 			g.Flags["bell-ceremony-turn"] = true
 
 			// Bell becomes hot and drops
-			item.Location = g.Location
-			room := g.Rooms[g.Location]
-			if room != nil {
-				room.AddItem("bell")
-			}
-			// Remove from inventory
-			for i, id := range g.Player.Inventory {
-				if id == "bell" {
-					g.Player.Inventory = append(g.Player.Inventory[:i], g.Player.Inventory[i+1:]...)
-					break
-				}
-			}
+			g.moveItem("bell", g.Location)
 
 			// If player has candles, they drop too
 			result := `The bell suddenly becomes red hot and falls to the ground. The wraiths, as if paralyzed, stop their jeering and slowly turn to face you. On their ashen faces, the expression of a long-forgotten terror takes shape.`
@@ -2440,17 +2266,8 @@ func (g *GameV2) handleRing(objName string) string {
 			if g.hasItemInInventory("candles") {
 				candles := g.Items["candles"]
 				if candles != nil {
-					candles.Location = g.Location
+					g.moveItem("candles", g.Location)
 					candles.Flags.IsLit = false
-					if room != nil {
-						room.AddItem("candles")
-					}
-					for i, id := range g.Player.Inventory {
-						if id == "candles" {
-							g.Player.Inventory = append(g.Player.Inventory[:i], g.Player.Inventory[i+1:]...)
-							break
-						}
-					}
 					result += "\nIn your confusion, the candles drop to the ground (and they are out)."
 				}
 			}
@@ -2573,12 +2390,11 @@ func (g *GameV2) handleOdysseus() string {
 	g.Flags["magic-flag"] = true     // East passage is now open
 
 	// Remove cyclops from room
-	room.RemoveNPC("cyclops")
 	cyclops := g.NPCs["cyclops"]
 	if cyclops != nil {
 		cyclops.Flags.IsAlive = false
 		cyclops.Flags.CanFight = false
-		cyclops.Location = ""
+		g.moveNPC(cyclops, "")
 	}
 
 	return "The cyclops, hearing the name of his father's deadly nemesis, flees the room by knocking down the wall on the east of the room."
@@ -2603,26 +2419,12 @@ func (g *GameV2) handleEat(objName string) string {
 
 	// Special cases for edible items
 	if item.ID == "lunch" {
-		// Remove from inventory
-		for i, id := range g.Player.Inventory {
-			if id == item.ID {
-				g.Player.Inventory = append(g.Player.Inventory[:i], g.Player.Inventory[i+1:]...)
-				break
-			}
-		}
-		delete(g.Items, item.ID)
+		g.removeItem(item.ID)
 		return "Thank you very much. It really hit the spot."
 	}
 
 	if item.ID == "garlic" {
-		// Remove from inventory
-		for i, id := range g.Player.Inventory {
-			if id == item.ID {
-				g.Player.Inventory = append(g.Player.Inventory[:i], g.Player.Inventory[i+1:]...)
-				break
-			}
-		}
-		delete(g.Items, item.ID)
+		g.removeItem(item.ID)
 		return "What the heck! You won't be bothered by vampires, anyway."
 	}
 
@@ -2692,15 +2494,15 @@ func (g *GameV2) handleInflate(objName string, toolName string) string {
 		return "You can't see any " + objName + " here."
 	}
 
-	// Only works on inflatable boat (deflated)
-	if boat.ID != "boat" && boat.ID != "inflatable-boat" {
+	// Only works on the boat, and only while it is deflated
+	if boat.ID != "boat" {
 		return "How can you inflate that?"
 	}
-
-	// Check if boat is already inflated
-	inflatedBoat := g.Items["inflated-boat"]
-	if inflatedBoat != nil && inflatedBoat.Location == g.Location {
+	switch g.BoatState {
+	case boatInflated:
 		return "Inflating it further would probably burst it."
+	case boatPunctured:
+		return "No chance. Some moron punctured it."
 	}
 
 	// Boat must be on the ground
@@ -2717,16 +2519,8 @@ func (g *GameV2) handleInflate(objName string, toolName string) string {
 	// Check if using pump
 	pump := g.findItem(tool)
 	if pump != nil && (pump.ID == "pump" || pump.ID == "air-pump") {
-		// Success! Inflate the boat
-		room := g.Rooms[g.Location]
-
-		// Remove deflated boat
-		room.RemoveItem(boat.ID)
-		boat.Location = ""
-
-		// Add inflated boat
-		inflatedBoat.Location = g.Location
-		room.AddItem("inflated-boat")
+		// Success! The boat becomes the magic boat
+		g.setBoatState(boatInflated)
 
 		// Reset deflate flag (allows passage through narrow areas)
 		g.Flags["deflate"] = true
@@ -2735,7 +2529,7 @@ func (g *GameV2) handleInflate(objName string, toolName string) string {
 
 		// Check if label hasn't been seen yet
 		label := g.Items["boat-label"]
-		if label != nil && label.Location == "inflated-boat" {
+		if label != nil && label.Location == "boat" {
 			result += "\nA tan label is lying inside the boat."
 		}
 
@@ -2759,12 +2553,12 @@ func (g *GameV2) handleDeflate(objName string) string {
 	}
 
 	// Only works on inflated boat
-	if boat.ID != "inflated-boat" {
+	if boat.ID != "boat" || g.BoatState != boatInflated {
 		return "Come on, now!"
 	}
 
 	// Can't deflate if player is in the boat
-	if g.Location == "inflated-boat" {
+	if g.Player.Vehicle == boat.ID {
 		return "You can't deflate the boat while you're in it."
 	}
 
@@ -2773,21 +2567,8 @@ func (g *GameV2) handleDeflate(objName string) string {
 		return "The boat must be on the ground to be deflated."
 	}
 
-	room := g.Rooms[g.Location]
-
-	// Remove inflated boat
-	room.RemoveItem("inflated-boat")
-	boat.Location = ""
-
-	// Add deflated boat back
-	deflatedBoat := g.Items["boat"]
-	if deflatedBoat == nil {
-		deflatedBoat = g.Items["inflatable-boat"]
-	}
-	if deflatedBoat != nil {
-		deflatedBoat.Location = g.Location
-		room.AddItem(deflatedBoat.ID)
-	}
+	// Anything left inside is folded up with it, as in ZIL
+	g.setBoatState(boatDeflated)
 
 	// Clear deflate flag (blocks passage through narrow areas)
 	g.Flags["deflate"] = false
@@ -2806,8 +2587,13 @@ func (g *GameV2) handlePlug(objName string, materialName string) string {
 		return "You can't see any " + objName + " here."
 	}
 
+	// The leak in the Maintenance Room (LEAK-FUNCTION in ZIL)
+	if boat.ID == "leak" {
+		return g.plugLeak(materialName)
+	}
+
 	// Only works on punctured boat
-	if boat.ID != "punctured-boat" {
+	if boat.ID != "boat" || g.BoatState != boatPunctured {
 		return "That doesn't need plugging."
 	}
 
@@ -2821,22 +2607,8 @@ func (g *GameV2) handlePlug(objName string, materialName string) string {
 		return "That won't work."
 	}
 
-	// Success! Repair the boat
-	room := g.Rooms[g.Location]
-
-	// Remove punctured boat
-	room.RemoveItem("punctured-boat")
-	boat.Location = ""
-
-	// Add deflated boat
-	deflatedBoat := g.Items["boat"]
-	if deflatedBoat == nil {
-		deflatedBoat = g.Items["inflatable-boat"]
-	}
-	if deflatedBoat != nil {
-		deflatedBoat.Location = g.Location
-		room.AddItem(deflatedBoat.ID)
-	}
+	// Success! The boat is whole again, though flat
+	g.setBoatState(boatDeflated)
 
 	return "Well done. The boat is repaired."
 }
@@ -2888,77 +2660,17 @@ func (g *GameV2) handleSmell(objName string) string {
 	return "You can't see any " + objName + " here."
 }
 
-// handleTouch touches something (V-TOUCH in ZIL)
-func (g *GameV2) handleTouch(objName string) string {
+// handleTouch touches or rubs something, optionally with a tool (V-TOUCH in ZIL)
+func (g *GameV2) handleTouch(objName, toolName string) string {
 	if objName == "" {
 		return "Touch what?"
 	}
 
 	item := g.findItem(objName)
 	if item != nil {
-		// Special case: rubbing mirrors (MIRROR-MIRROR in ZIL lines 971-1012)
-	if (item.ID == "mirror-1" || item.ID == "mirror-2") && !g.Flags["mirror-mung"] {
-		// Determine the two rooms
-		room1 := "mirror-room-1"
-		room2 := "mirror-room-2"
-
-		// Determine which room we're in and which is the other
-		var fromRoom, toRoom string
-		if g.Location == room1 {
-			fromRoom = room1
-			toRoom = room2
-		} else if g.Location == room2 {
-			fromRoom = room2
-			toRoom = room1
-		} else {
-			return "You feel nothing unexpected."
-		}
-
-		// Swap ALL items between the two rooms (excluding mirrors and NPCs)
-		fromRoomObj := g.Rooms[fromRoom]
-		toRoomObj := g.Rooms[toRoom]
-
-		if fromRoomObj == nil || toRoomObj == nil {
-			return "You feel nothing unexpected."
-		}
-
-		// Collect items to move (excluding mirrors themselves)
-		var fromItems []string
-		for _, itemID := range fromRoomObj.Contents {
-			if itemID != "mirror-1" && itemID != "mirror-2" {
-				fromItems = append(fromItems, itemID)
-			}
-		}
-
-		var toItems []string
-		for _, itemID := range toRoomObj.Contents {
-			if itemID != "mirror-1" && itemID != "mirror-2" {
-				toItems = append(toItems, itemID)
-			}
-		}
-
-		// Move items from fromRoom to toRoom
-		for _, itemID := range fromItems {
-			if item := g.Items[itemID]; item != nil {
-				fromRoomObj.RemoveItem(itemID)
-				item.Location = toRoom
-				toRoomObj.AddItem(itemID)
-			}
-		}
-
-		// Move items from toRoom to fromRoom
-		for _, itemID := range toItems {
-			if item := g.Items[itemID]; item != nil {
-				toRoomObj.RemoveItem(itemID)
-				item.Location = fromRoom
-				fromRoomObj.AddItem(itemID)
-			}
-		}
-
-		// Teleport player to the other room
-		g.Location = toRoom
-
-		return "There is a rumble from deep within the earth and the room shakes.\n\n" + g.handleLook()
+		// Special case: rubbing mirrors (MIRROR-MIRROR in ZIL)
+		if isMirror(item) {
+			return g.rubMirror(toolName)
 		}
 
 		return "You feel nothing unexpected."
@@ -2980,7 +2692,7 @@ func (g *GameV2) handleTouch(objName string) string {
 }
 
 // handleBreak breaks/smashes something (V-MUNG in ZIL)
-func (g *GameV2) handleBreak(objName string) string {
+func (g *GameV2) handleBreak(objName, toolName string) string {
 	if objName == "" {
 		return "Break what?"
 	}
@@ -2991,16 +2703,13 @@ func (g *GameV2) handleBreak(objName string) string {
 	}
 
 	// Special case: breaking mirrors (MIRROR-MIRROR in ZIL lines 1003-1012)
-	if item.ID == "mirror-1" || item.ID == "mirror-2" {
-		if g.Flags["mirror-mung"] {
-			return "Haven't you done enough damage already?"
-		}
-
-		// Break the mirror
-		g.Flags["mirror-mung"] = true
-		g.Flags["lucky"] = false
+	if isMirror(item) {
+		return g.breakMirror()
+	}
 
-		return "You have broken the mirror. I hope you have a seven years' supply of good luck handy."
+	// Special case: forcing the egg open (EGG-OBJECT in ZIL)
+	if item.ID == "egg" {
+		return g.openEgg(item, toolName, true)
 	}
 
 	// Default: can't break most things
@@ -3008,7 +2717,7 @@ func (g *GameV2) handleBreak(objName string) string {
 }
 
 // handleBurn burns something (BLACK-BOOK burn handling in ZIL lines 2201-2205)
-func (g *GameV2) handleBurn(objName string) string {
+func (g *GameV2) handleBurn(objName, toolName string) string {
 	if objName == "" {
 		return "Burn what?"
 	}
@@ -3018,29 +2727,18 @@ func (g *GameV2) handleBurn(objName string) string {
 		return "You can't see any " + objName + " here."
 	}
 
+	// Burning a match, the candles or the fuse just lights them
+	// (MATCH-FUNCTION, CANDLES-FCN and FUSE-FUNCTION in ZIL)
+	if item.ID == "match" || item.ID == "candles" || item.ID == "fuse" {
+		return g.handleTurnOn(objName, toolName)
+	}
+
 	// Special case: burning the prayer book is DEADLY (BLACK-BOOK in ZIL)
 	if item.ID == "book" {
 		// Remove the book
-		if item.Location == "inventory" {
-			for i, id := range g.Player.Inventory {
-				if id == item.ID {
-					g.Player.Inventory = append(g.Player.Inventory[:i], g.Player.Inventory[i+1:]...)
-					break
-				}
-			}
-		} else {
-			room := g.Rooms[item.Location]
-			if room != nil {
-				room.RemoveItem(item.ID)
-			}
-		}
-		item.Location = "REMOVED"
-
-		// Game over!
-		g.GameOver = true
-		return `A booming voice says "Wrong, cretin!" and you notice that you have turned into a pile of dust. How, I can't imagine.
+		g.removeItem(item.ID)
 
-****  You have died  ****`
+		return g.jigsUp(`A booming voice says "Wrong, cretin!" and you notice that you have turned into a pile of dust. How, I can't imagine.`)
 	}
 
 	// Default: can't burn most things
@@ -3076,18 +2774,13 @@ func (g *GameV2) handleSearch(objName string) string {
 		}
 
 		var itemNames []string
-		for _, itemID := range npc.Inventory {
+		for _, itemID := range append([]string{}, npc.Inventory...) {
 			if foundItem := g.Items[itemID]; foundItem != nil {
 				itemNames = append(itemNames, foundItem.Name)
 				// Move item to current room
-				foundItem.Location = g.Location
-				room := g.Rooms[g.Location]
-				if room != nil {
-					room.AddItem(itemID)
-				}
+				g.moveItem(itemID, g.Location)
 			}
 		}
-		npc.Inventory = []string{} // Clear inventory
 
 		if len(itemNames) == 0 {
 			return "The " + npc.Name + " has nothing of interest."
@@ -3272,8 +2965,14 @@ func (g *GameV2) handleEnter(cmd *Command) string {
 		return g.handleMove("in")
 	}
 
-	// For now, just try to move through it as a direction
-	// In full ZIL this would handle boats, vehicles, etc.
+	if (item.ID == "barrow" || item.ID == "barrow-door") && g.Location == "stone-barrow" {
+		return g.handleMove("in")
+	}
+
+	if isVehicle(item) {
+		return g.handleBoard(cmd.DirectObject)
+	}
+
 	return "You can't enter that."
 }
 
@@ -3293,6 +2992,13 @@ func (g *GameV2) handleThrow(cmd *Command) string {
 		return "You're not holding the " + item.Name + "."
 	}
 
+	// Special case: the egg lands where you stand, rather the worse for it
+	// (EGG-OBJECT in ZIL)
+	if item.ID == "egg" {
+		g.moveItem(item.ID, g.Location)
+		return g.crushEgg(true)
+	}
+
 	// Special case: throwing at something
 	if cmd.IndirectObject != "" {
 		// Check if target exists (item or NPC)
@@ -3308,12 +3014,17 @@ func (g *GameV2) handleThrow(cmd *Command) string {
 			if targetNPC.Flags.IsAlive && targetNPC.Flags.IsAggressive {
 				// Drop the item and trigger combat
 				g.handleDrop(cmd.DirectObject)
+				targetNPC.Hostile = true
+				targetNPC.Flags.IsFighting = targetNPC.Flags.CanFight
 				return fmt.Sprintf("The %s bounces harmlessly off the %s, who looks very angry!", item.Name, targetNPC.Name)
 			}
 			return fmt.Sprintf("The %s bounces harmlessly off the %s.", item.Name, targetNPC.Name)
 		}
 
 		// Throwing at an item
+		if isMirror(targetItem) {
+			return g.breakMirror()
+		}
 		return fmt.Sprintf("The %s bounces harmlessly off the %s.", item.Name, targetItem.Name)
 	}
 
@@ -3331,33 +3042,51 @@ func (g *GameV2) handleYell() string {
 	return "You scream loudly. Nothing happens."
 }
 
-// handleBoard handles boarding vehicles (V-BOARD in ZIL)
-func (g *GameV2) handleBoard(objName string) string {
-	if objName == "" {
-		return "Board what?"
-	}
+// handleDiagnose handles the DIAGNOSE command (V-DIAGNOSE in ZIL)
+func (g *GameV2) handleDiagnose() string {
+	var result strings.Builder
 
-	item := g.findItem(objName)
-	if item == nil {
-		return "You can't see any " + objName + " here."
+	wounds := g.woundPoints()
+	switch {
+	case wounds == 0:
+		result.WriteString("You are in perfect health.")
+	case wounds == 1:
+		result.WriteString("You have a light wound,")
+	case wounds == 2:
+		result.WriteString("You have a serious wound,")
+	case wounds == 3:
+		result.WriteString("You have several wounds,")
+	default:
+		result.WriteString("You have serious wounds,")
+	}
+	if wounds > 0 {
+		result.WriteString(fmt.Sprintf(" which will be cured after %d moves.", g.turnsToHeal()))
+	}
+
+	result.WriteString("\nYou can ")
+	switch strength := g.baseFightStrength() + g.Player.StrengthModifier; {
+	case strength <= 0:
+		result.WriteString("expect death soon")
+	case strength == 1:
+		result.WriteString("be killed by one more light wound")
+	case strength == 2:
+		result.WriteString("be killed by a serious wound")
+	case strength == 3:
+		result.WriteString("survive one serious wound")
+	default:
+		result.WriteString("survive several wounds")
 	}
+	result.WriteString(".")
 
-	// Check if it's the boat
-	if objName == "boat" || objName == "raft" {
-		if item.Location != g.Location && item.Location != "inventory" {
-			return "The boat isn't here."
-		}
-		return "You are now in the boat."
+	switch g.Deaths {
+	case 0:
+	case 1:
+		result.WriteString("\nYou have been killed once.")
+	default:
+		result.WriteString("\nYou have been killed twice.")
 	}
 
-	return "You can't board that."
-}
-
-// handleDiagnose handles the DIAGNOSE command (V-DIAGNOSE in ZIL)
-func (g *GameV2) handleDiagnose() string {
-	// In original Zork, this would report health status
-	// For now, simple implementation
-	return "You are in perfect health."
+	return result.String()
 }
 
 // handleSay handles SAY command (V-SAY in ZIL)
@@ -3381,7 +3110,7 @@ func (g *GameV2) handleSay(cmd *Command) string {
 
 // handleEcho handles ECHO command (V-ECHO in ZIL)
 func (g *GameV2) handleEcho() string {
-	return g.handleYell()
+	return "echo echo ..."
 }
 
 // handleTalk handles talking to NPCs (V-TELL in ZIL)
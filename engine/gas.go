@@ -0,0 +1,126 @@
+package engine
+
+// The Gas Room (BOOM-ROOM in ZIL)
+//
+// The Gas Room is full of coal gas. An electric lamp is safe enough, but
+// anyone carrying a naked flame into it, or lighting one there, is blown to
+// bits.
+//
+// The brick is the safe way to set the gas off. Its fuse smoulders rather
+// than flames, so a player can light it, leave the brick in the Gas Room and
+// get out before it blows. The gas burns away with it, after which flames
+// are harmless there.
+
+// fuseBurnTurns is how long the fuse burns before the brick goes off (I-FUSE
+// in ZIL)
+const fuseBurnTurns = 5
+
+// boom is the last thing the player hears in the Gas Room
+const boom = "      ** BOOOOOOOOOOOM **"
+
+// carriedFlame returns a burning candle, torch or match the player is
+// holding, or nil (FLAMEBIT and ONBIT in ZIL)
+func (g *GameV2) carriedFlame() *Item {
+	for _, id := range g.Player.Inventory {
+		if item := g.Items[id]; item != nil && item.Flags.IsFlame && item.Flags.IsLit {
+			return item
+		}
+	}
+	return nil
+}
+
+// gasRoomAction sets off the gas when the player ends a turn in the Gas Room
+// with a flame (BOOM-ROOM M-END in ZIL)
+func gasRoomAction(g *GameV2, event RoomEvent) string {
+	if event == EventLook && g.Flags["gas-burned"] {
+		return "This is a small room, its walls blackened by a recent explosion. There is a short climb up some stairs and a narrow tunnel leading east."
+	}
+	if event != EventEnd || g.Dead || g.GameOver || g.Flags["gas-burned"] || g.carriedFlame() == nil {
+		return ""
+	}
+	return g.jigsUp("Oh dear. It appears that the smell coming from this room was coal gas. I would have thought twice about carrying flaming objects in here.\n\n" + boom)
+}
+
+// gasIgnites handles lighting a flame in the Gas Room (BOOM-ROOM in ZIL). It
+// returns "" anywhere else.
+func (g *GameV2) gasIgnites(item *Item) string {
+	if g.Location != "gas-room" || !item.Flags.IsFlame || g.Dead || g.Flags["gas-burned"] {
+		return ""
+	}
+	return g.jigsUp("How sad for an aspiring adventurer to light a " + item.Name + " in a room which reeks of gas. Fortunately, there is justice in the world.\n\n" + boom)
+}
+
+// lightFuse sets the fuse burning with a flame (FUSE-FUNCTION in ZIL)
+func (g *GameV2) lightFuse(toolName string) string {
+	fuse := g.Items["fuse"]
+	if fuse.Flags.IsLit {
+		return "The fuse is already burning."
+	}
+
+	prefix := ""
+	var flame *Item
+	if toolName == "" {
+		if flame = g.carriedFlame(); flame == nil {
+			return "You should say what to light it with."
+		}
+		name := flame.Name
+		if flame.ID == "match" {
+			name = "match" // The item is the whole matchbook
+		}
+		prefix = "(with the " + name + ")\n"
+	} else if flame = g.findItem(toolName); flame == nil {
+		return "You can't see any " + toolName + " here."
+	}
+	if !flame.Flags.IsFlame || !flame.Flags.IsLit {
+		return "You have to light it with something that's burning, you know."
+	}
+
+	fuse.Flags.IsLit = true
+	fuse.Fuel = fuseBurnTurns
+	return prefix + "The wire starts to burn."
+}
+
+// processFuse burns the fuse down and sets off the brick if the fuse is in
+// it (I-FUSE in ZIL)
+func (g *GameV2) processFuse() string {
+	fuse := g.Items["fuse"]
+	if fuse == nil || !fuse.Flags.IsLit || fuse.Fuel <= 0 {
+		return ""
+	}
+
+	fuse.Fuel--
+	if fuse.Fuel > 0 {
+		return ""
+	}
+	fuse.Flags.IsLit = false
+	if g.parentOf("fuse") == "brick" {
+		return g.brickExplodes()
+	}
+
+	seen := g.outermostLocation("fuse") == "inventory" || g.outermostLocation("fuse") == g.Location
+	g.removeItem("fuse")
+	if seen {
+		return "The wire rapidly burns into nothingness."
+	}
+	return ""
+}
+
+// brickExplodes blows up the brick and whatever is holding it. In the Gas
+// Room it takes the gas with it (BRICK-BOOM in ZIL).
+func (g *GameV2) brickExplodes() string {
+	where := g.outermostLocation("brick")
+	g.removeItem("fuse")
+	g.removeItem("brick")
+
+	if where == "gas-room" && !g.Flags["gas-burned"] {
+		g.Flags["gas-burned"] = true
+		if g.Location == "gas-room" {
+			return g.jigsUp("The brick explodes, and the coal gas goes up with it.\n\n" + boom)
+		}
+		return "The whole mine shakes with a tremendous explosion, and the smell of coal gas is gone."
+	}
+	if where == "inventory" || where == g.Location {
+		return g.jigsUp("Now you've done it. It seems that the brick has other properties than weight, namely the ability to blow you to smithereens.")
+	}
+	return "You hear a distant explosion."
+}
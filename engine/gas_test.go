@@ -0,0 +1,117 @@
+package engine
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGasRoomIsSafeWithLamp(t *testing.T) {
+	g := NewGameV2("test")
+	g.Location = "smelly-room"
+	g.moveItem("lamp", "inventory")
+	g.Items["lamp"].Flags.IsLit = true
+
+	result := g.Process("down")
+	if g.Location != "gas-room" || g.Deaths != 0 {
+		t.Fatalf("Expected to stand safely in the gas room, got: %s", result)
+	}
+	if !strings.Contains(result, "sapphire-encrusted bracelet") {
+		t.Errorf("Expected the bracelet, got: %s", result)
+	}
+}
+
+func TestFlameInGasRoomExplodes(t *testing.T) {
+	g := NewGameV2("test")
+	g.Location = "smelly-room"
+	g.moveItem("torch", "inventory")
+
+	result := g.Process("down")
+	if g.Deaths != 1 || !strings.Contains(result, "coal gas") || !strings.Contains(result, "BOOOOOOOOOOOM") {
+		t.Errorf("Expected the torch to set off the gas, got: %s", result)
+	}
+}
+
+func TestStrikingMatchInGasRoom(t *testing.T) {
+	g := NewGameV2("test")
+	g.Location = "gas-room"
+	g.moveItem("lamp", "inventory")
+	g.Items["lamp"].Flags.IsLit = true
+	g.moveItem("match", "inventory")
+
+	result := g.Process("strike match")
+	if g.Deaths != 1 || !strings.Contains(result, "How sad for an aspiring adventurer to light a matchbook") {
+		t.Errorf("Expected the match to set off the gas, got: %s", result)
+	}
+	if strings.Count(result, "BOOOOOOOOOOOM") != 1 {
+		t.Errorf("Expected a single explosion, got: %s", result)
+	}
+}
+
+func TestBrickBurnsOffGas(t *testing.T) {
+	g := NewGameV2("test")
+	g.Location = "smelly-room"
+	g.moveItem("lamp", "inventory")
+	g.Items["lamp"].Flags.IsLit = true
+	g.moveItem("brick", "inventory")
+	g.moveItem("match", "inventory")
+
+	g.Process("light match")
+	if result := g.Process("light fuse"); !strings.Contains(result, "(with the match)") || !strings.Contains(result, "The wire starts to burn.") {
+		t.Fatalf("light fuse with a burning match: %s", result)
+	}
+
+	// The smouldering fuse is safe to carry into the gas
+	g.Process("down")
+	g.Process("drop brick")
+	g.Process("up")
+	if g.Deaths != 0 {
+		t.Fatal("Carrying the lit brick through the gas room should be safe")
+	}
+	if result := g.Process("wait"); !strings.Contains(result, "tremendous explosion") {
+		t.Errorf("Expected the brick to set off the gas, got: %s", result)
+	}
+	if !g.Flags["gas-burned"] || g.Items["brick"].Location != "" {
+		t.Error("The gas and the brick should both be gone")
+	}
+
+	g.moveItem("torch", "inventory")
+	result := g.Process("down")
+	if g.Location != "gas-room" || g.Deaths != 0 {
+		t.Fatalf("Expected the torch to be safe once the gas is gone, got: %s", result)
+	}
+	if !strings.Contains(result, "blackened by a recent explosion") {
+		t.Errorf("Expected the scorched gas room, got: %s", result)
+	}
+}
+
+func TestBrickKillsPlayerHoldingIt(t *testing.T) {
+	g := NewGameV2("test")
+	g.Location = "dead-end-5"
+	g.moveItem("torch", "inventory")
+
+	if result := g.Process("light fuse with torch"); !strings.Contains(result, "The wire starts to burn.") {
+		t.Fatalf("light fuse with torch: %s", result)
+	}
+	g.Process("take brick")
+	var result string
+	for i := 0; i < fuseBurnTurns && g.Deaths == 0; i++ {
+		result = g.Process("wait")
+	}
+	if g.Deaths != 1 || !strings.Contains(result, "blow you to smithereens") {
+		t.Errorf("Expected the brick to kill the player holding it, got: %s", result)
+	}
+}
+
+func TestFuseNeedsFlame(t *testing.T) {
+	g := NewGameV2("test")
+	g.Location = "dead-end-5"
+	g.moveItem("lamp", "inventory")
+	g.Items["lamp"].Flags.IsLit = true
+
+	if result := g.Process("light fuse"); !strings.Contains(result, "say what to light it with") {
+		t.Errorf("light fuse without a flame: %s", result)
+	}
+	if result := g.Process("light fuse with lamp"); !strings.Contains(result, "something that's burning") {
+		t.Errorf("light fuse with the lamp: %s", result)
+	}
+}
@@ -0,0 +1,59 @@
+package engine
+
+// Wounds and healing (P?STRENGTH, I-CURE and CURE-WAIT in ZIL)
+//
+// Wounds lower Player.StrengthModifier below zero. Each point costs the
+// player fighting strength and 10 off the load limit. One point heals every
+// cureWait turns; a wound that leaves the player no strength at all is fatal.
+
+// cureWait is how many turns it takes to heal one point of wound damage
+const cureWait = 30
+
+// wound takes points of strength off the player and restarts the healing
+// clock (WINNER-RESULT in ZIL)
+func (g *GameV2) wound(points int) {
+	g.Player.StrengthModifier -= points
+	g.Player.CureTurns = cureWait
+
+	if g.baseFightStrength()+g.Player.StrengthModifier <= 0 {
+		g.Player.StrengthModifier = -g.baseFightStrength()
+		g.Player.Health = 0
+	}
+}
+
+// woundPoints returns how many points of strength the player has lost to
+// wounds
+func (g *GameV2) woundPoints() int {
+	if g.Player.StrengthModifier >= 0 {
+		return 0
+	}
+	return -g.Player.StrengthModifier
+}
+
+// turnsToHeal returns how many turns until every wound has healed
+func (g *GameV2) turnsToHeal() int {
+	wounds := g.woundPoints()
+	if wounds == 0 {
+		return 0
+	}
+	return cureWait*(wounds-1) + g.Player.CureTurns
+}
+
+// processCure heals one point of wound damage every cureWait turns
+// (I-CURE in ZIL)
+func (g *GameV2) processCure() {
+	if g.woundPoints() == 0 {
+		g.Player.CureTurns = 0
+		return
+	}
+
+	g.Player.CureTurns--
+	if g.Player.CureTurns > 0 {
+		return
+	}
+
+	g.Player.StrengthModifier++
+	if g.woundPoints() > 0 {
+		g.Player.CureTurns = cureWait
+	}
+}
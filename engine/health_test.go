@@ -0,0 +1,72 @@
+package engine
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestDiagnoseReportsWounds(t *testing.T) {
+	g := NewGameV2("test")
+	g.Score = 140 // Fighting strength 4
+
+	if got := g.Process("diagnose"); !strings.HasPrefix(got, "You are in perfect health.\nYou can survive several wounds.") {
+		t.Errorf("unwounded diagnose = %q", got)
+	}
+
+	g.applyVillainOutcome(CombatSeriousWound)
+	got := g.handleDiagnose()
+	want := "You have a serious wound, which will be cured after 60 moves.\nYou can be killed by a serious wound."
+	if got != want {
+		t.Errorf("wounded diagnose = %q, want %q", got, want)
+	}
+
+	g.Deaths = 1
+	if got := g.handleDiagnose(); !strings.HasSuffix(got, "You have been killed once.") {
+		t.Errorf("diagnose should mention the death, got %q", got)
+	}
+}
+
+func TestWoundsHealOverTime(t *testing.T) {
+	g := NewGameV2("test")
+	g.Score = 140
+	g.applyVillainOutcome(CombatSeriousWound)
+	if g.loadAllowed() != g.Player.MaxWeight-20 {
+		t.Fatalf("loadAllowed = %d, want %d while wounded", g.loadAllowed(), g.Player.MaxWeight-20)
+	}
+
+	for i := 0; i < cureWait; i++ {
+		g.Process("wait")
+	}
+	if g.Player.StrengthModifier != -1 {
+		t.Fatalf("StrengthModifier = %d after %d turns, want -1", g.Player.StrengthModifier, cureWait)
+	}
+	if w, turns := g.woundPoints(), g.turnsToHeal(); w != 1 || turns != cureWait {
+		t.Errorf("woundPoints = %d, turnsToHeal = %d; want 1 wound healing in %d turns", w, turns, cureWait)
+	}
+	if want := fmt.Sprintf("which will be cured after %d moves", cureWait); !strings.Contains(g.Process("diagnose"), want) {
+		t.Errorf("DIAGNOSE doesn't say %q", want)
+	}
+	if strength := g.baseFightStrength() + g.Player.StrengthModifier; strength != 3 {
+		t.Errorf("fighting strength = %d, want 3", strength)
+	}
+
+	for i := 0; i < cureWait; i++ {
+		g.Process("wait")
+	}
+	if g.Player.StrengthModifier != 0 || g.loadAllowed() != g.Player.MaxWeight {
+		t.Errorf("StrengthModifier = %d, loadAllowed = %d; want fully healed", g.Player.StrengthModifier, g.loadAllowed())
+	}
+}
+
+func TestWoundWithNoStrengthLeftIsFatal(t *testing.T) {
+	g := NewGameV2("test") // Score 0: fighting strength 2
+	g.applyVillainOutcome(CombatLightWound)
+	if g.Player.Health <= 0 {
+		t.Fatal("a light wound should not kill a healthy player")
+	}
+	g.applyVillainOutcome(CombatLightWound)
+	if g.Player.Health > 0 {
+		t.Error("a wound that leaves no strength should be fatal")
+	}
+}
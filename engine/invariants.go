@@ -0,0 +1,227 @@
+package engine
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// InvariantViolation records the first command that left the world in an
+// inconsistent state (debug mode only)
+type InvariantViolation struct {
+	Command  string   // Raw input of the offending command
+	Move     int      // Move number the command ran on
+	Problems []string // Everything that was wrong afterwards
+}
+
+// String formats the violation for the player's transcript
+func (v *InvariantViolation) String() string {
+	return fmt.Sprintf("[debug] World invariants broken by %q on move %d:\n  %s",
+		v.Command, v.Move, strings.Join(v.Problems, "\n  "))
+}
+
+// checkInvariantsAfter runs the invariant checker after a command when debug
+// mode is on. Only the first violation is reported, since everything after
+// it is built on a broken world.
+func (g *GameV2) checkInvariantsAfter(cmd *Command) string {
+	if !g.Debug || g.Violation != nil {
+		return ""
+	}
+
+	problems := g.CheckInvariants()
+	if len(problems) == 0 {
+		return ""
+	}
+
+	g.Violation = &InvariantViolation{Command: cmd.Raw, Move: g.Moves, Problems: problems}
+	return g.Violation.String()
+}
+
+// CheckInvariants verifies that the world model is self-consistent and returns
+// a description of every problem found:
+//   - Item.Location agrees with Room.Contents, Player.Inventory, NPC.Inventory
+//     and container contents
+//   - no item is listed in two places
+//   - containment has no cycles
+//   - NPC locations agree with Room.NPCs, and duplicated flags agree
+//   - the player's vehicle is in the player's room
+func (g *GameV2) CheckInvariants() []string {
+	var problems []string
+	problems = append(problems, g.checkItemViews()...)
+	problems = append(problems, g.checkContainmentCycles()...)
+	problems = append(problems, g.checkNPCViews()...)
+	problems = append(problems, g.checkFlags()...)
+	sort.Strings(problems)
+	return problems
+}
+
+// checkItemViews compares each item's Location against the lists that hold it
+func (g *GameV2) checkItemViews() []string {
+	var problems []string
+	listed := make(map[string][]string) // item ID -> places it is listed
+
+	for roomID, room := range g.Rooms {
+		for _, id := range room.Contents {
+			listed[id] = append(listed[id], roomID)
+		}
+	}
+	for _, id := range g.Player.Inventory {
+		listed[id] = append(listed[id], "inventory")
+	}
+	for npcID, npc := range g.NPCs {
+		for _, id := range npc.Inventory {
+			listed[id] = append(listed[id], npcID)
+		}
+	}
+	for containerID, list := range g.itemContents {
+		for _, id := range *list {
+			listed[id] = append(listed[id], containerID)
+		}
+	}
+
+	for id, places := range listed {
+		item := g.Items[id]
+		if item == nil {
+			problems = append(problems, fmt.Sprintf("%s is listed in %s but does not exist", id, strings.Join(places, ", ")))
+			continue
+		}
+		// Global objects (trap door, grating) are visible from several rooms
+		if item.Location == "GLOBAL" {
+			continue
+		}
+		if len(places) > 1 {
+			problems = append(problems, fmt.Sprintf("%s is listed in more than one place: %s", id, strings.Join(places, ", ")))
+		}
+		for _, place := range places {
+			if !g.locationMatches(item, place) {
+				problems = append(problems, fmt.Sprintf("%s is listed in %s but its Location is %q", id, place, item.Location))
+			}
+		}
+	}
+
+	for key, item := range g.Items {
+		// Some items are registered under a second key (bag-of-coins -> coins)
+		if key != item.ID {
+			continue
+		}
+		switch {
+		case item.Location == "" || item.Location == "GLOBAL":
+			// Out of play, or visible everywhere it is listed
+		case item.Location == "inventory":
+			if !containsString(g.Player.Inventory, item.ID) {
+				problems = append(problems, item.ID+" has Location \"inventory\" but is not in the inventory")
+			}
+		case g.Rooms[item.Location] != nil:
+			if !containsString(g.Rooms[item.Location].Contents, item.ID) {
+				problems = append(problems, fmt.Sprintf("%s has Location %q but is not in that room", item.ID, item.Location))
+			}
+		case g.npcHolding(item) != nil:
+			if !g.npcHolding(item).HasItem(item.ID) {
+				problems = append(problems, fmt.Sprintf("%s has Location %q but is not in that NPC's inventory", item.ID, item.Location))
+			}
+		case g.Items[item.Location] != nil:
+			if !containsString(g.contentsOf(item.Location), item.ID) {
+				problems = append(problems, fmt.Sprintf("%s has Location %q but is not in that container", item.ID, item.Location))
+			}
+		default:
+			problems = append(problems, fmt.Sprintf("%s has unknown Location %q", item.ID, item.Location))
+		}
+	}
+
+	return problems
+}
+
+// locationMatches reports whether an item listed in place (a room ID,
+// "inventory", an NPC ID or a container) has a Location that says the same
+// thing
+func (g *GameV2) locationMatches(item *Item, place string) bool {
+	return canonicalLocation(item.Location) == place
+}
+
+// npcHolding returns the NPC an item's Location refers to, if any
+func (g *GameV2) npcHolding(item *Item) *NPC {
+	return g.NPCs[canonicalLocation(item.Location)]
+}
+
+// checkContainmentCycles follows Location through containers and reports any
+// item that ends up inside itself
+func (g *GameV2) checkContainmentCycles() []string {
+	var problems []string
+	for key, item := range g.Items {
+		if key != item.ID {
+			continue
+		}
+		seen := map[string]bool{item.ID: true}
+		for loc := item.Location; g.Items[loc] != nil; loc = g.Items[loc].Location {
+			if seen[loc] {
+				problems = append(problems, fmt.Sprintf("%s is inside a containment cycle through %s", item.ID, loc))
+				break
+			}
+			seen[loc] = true
+		}
+	}
+	return problems
+}
+
+// checkNPCViews compares each NPC's Location with the rooms listing it
+func (g *GameV2) checkNPCViews() []string {
+	var problems []string
+	for roomID, room := range g.Rooms {
+		for _, npcID := range room.NPCs {
+			npc := g.NPCs[npcID]
+			if npc == nil {
+				problems = append(problems, fmt.Sprintf("%s lists unknown NPC %s", roomID, npcID))
+			} else if npc.Location != roomID {
+				problems = append(problems, fmt.Sprintf("%s is listed in %s but its Location is %q", npcID, roomID, npc.Location))
+			}
+		}
+	}
+	for id, npc := range g.NPCs {
+		if room := g.Rooms[npc.Location]; room != nil && !room.HasNPC(id) {
+			problems = append(problems, fmt.Sprintf("%s has Location %q but is not in that room", id, npc.Location))
+		}
+	}
+	return problems
+}
+
+// checkFlags looks for flags that say the same thing in two places
+func (g *GameV2) checkFlags() []string {
+	var problems []string
+
+	for key, item := range g.Items {
+		if key == item.ID && item.Flags.IsLit && !item.Flags.IsLightSource {
+			problems = append(problems, item.ID+" is lit but is not a light source")
+		}
+	}
+
+	if w := g.Items["kitchen-window"]; w != nil && w.Flags.IsOpen != g.Flags["window-open"] {
+		problems = append(problems, "kitchen-window IsOpen disagrees with window-open flag")
+	}
+	if gr := g.Items["grate"]; gr != nil && gr.Flags.IsOpen != g.Flags["grate-open"] {
+		problems = append(problems, "grate IsOpen disagrees with grate-open flag")
+	}
+	if td := g.Items["trap-door"]; td != nil && td.Flags.IsOpen && !g.Flags["trap-door-open"] {
+		problems = append(problems, "trap-door is open but the rug was never moved")
+	}
+	if g.Flags["troll-dead"] && g.Rooms["troll-room"].HasNPC("troll") && g.NPCs["troll"].Flags.IsAlive && !g.NPCs["troll"].Flags.IsUnconscious {
+		problems = append(problems, "troll-dead is set but a conscious troll is still in the troll room")
+	}
+	if g.Flags["magic-flag"] && !g.Flags["cyclops-flag"] {
+		problems = append(problems, "magic-flag is set but cyclops-flag is not")
+	}
+	if v := g.vehicle(); v != nil && g.parentOf(v.ID) != g.Location {
+		problems = append(problems, "player is in the "+v.ID+" but it is not in their room")
+	}
+
+	return problems
+}
+
+// containsString reports whether list contains s
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
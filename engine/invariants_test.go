@@ -0,0 +1,100 @@
+package engine
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestInvariantsHoldAtStart(t *testing.T) {
+	g := NewGameV2("test")
+	if problems := g.CheckInvariants(); len(problems) > 0 {
+		t.Errorf("Fresh game breaks invariants:\n%s", strings.Join(problems, "\n"))
+	}
+}
+
+func TestInvariantChecks(t *testing.T) {
+	tests := []struct {
+		name     string
+		corrupt  func(*GameV2)
+		contains string
+	}{
+		{
+			name: "inventory without location",
+			corrupt: func(g *GameV2) {
+				g.Player.Inventory = append(g.Player.Inventory, "lamp")
+			},
+			contains: "lamp is listed in more than one place",
+		},
+		{
+			name: "location without listing",
+			corrupt: func(g *GameV2) {
+				g.Items["sword"].Location = "kitchen"
+			},
+			contains: "sword has Location \"kitchen\" but is not in that room",
+		},
+		{
+			name: "containment cycle",
+			corrupt: func(g *GameV2) {
+				g.Rooms["west-of-house"].RemoveItem("mailbox")
+				g.Items["mailbox"].Location = "leaflet"
+			},
+			contains: "containment cycle",
+		},
+		{
+			name: "npc in wrong room",
+			corrupt: func(g *GameV2) {
+				g.NPCs["troll"].Location = "cellar"
+			},
+			contains: "troll is listed in troll-room",
+		},
+		{
+			name: "window flag disagrees",
+			corrupt: func(g *GameV2) {
+				g.Flags["window-open"] = true
+			},
+			contains: "kitchen-window IsOpen disagrees",
+		},
+		{
+			name: "vehicle left behind",
+			corrupt: func(g *GameV2) {
+				g.setBoatState(boatInflated)
+				g.Player.Vehicle = "boat"
+			},
+			contains: "player is in the boat but it is not in their room",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewGameV2("test")
+			tt.corrupt(g)
+			problems := strings.Join(g.CheckInvariants(), "\n")
+			if !strings.Contains(problems, tt.contains) {
+				t.Errorf("Expected a problem containing %q, got:\n%s", tt.contains, problems)
+			}
+		})
+	}
+}
+
+func TestDebugModeReportsFirstViolation(t *testing.T) {
+	g := NewGameV2("test")
+	g.Debug = true
+
+	if result := g.Process("look"); strings.Contains(result, "[debug]") {
+		t.Fatalf("Unexpected debug report on a clean world:\n%s", result)
+	}
+
+	g.Items["sword"].Location = "kitchen"
+	result := g.Process("wait")
+	if !strings.Contains(result, `World invariants broken by "wait"`) {
+		t.Errorf("Expected report naming the command, got:\n%s", result)
+	}
+	if g.Violation == nil || g.Violation.Command != "wait" {
+		t.Errorf("Expected Violation to record \"wait\", got %+v", g.Violation)
+	}
+
+	// Later commands build on a broken world and are not reported again
+	if result := g.Process("look"); strings.Contains(result, "[debug]") {
+		t.Errorf("Expected only the first violation to be reported, got:\n%s", result)
+	}
+}
@@ -42,8 +42,10 @@ func createTreasures(g *GameV2) {
 	chalice.Location = "treasure-room"
 	chalice.RoomDescription = "There is a silver chalice, intricately engraved, here."
 	chalice.Flags.IsTakeable = true
+	chalice.Weight = 10 // ZIL SIZE
 	chalice.Flags.IsTreasure = true
 	chalice.Flags.IsContainer = true
+	chalice.Capacity = 5 // ZIL CAPACITY
 	chalice.Value = 5 // ZIL: 5
 	g.Items["chalice"] = chalice
 
@@ -52,6 +54,7 @@ func createTreasures(g *GameV2) {
 	jade.Aliases = []string{"jade", "figurine", "treasure", "exquisite"}
 	jade.RoomDescription = "There is an exquisite jade figurine here."
 	jade.Flags.IsTakeable = true
+	jade.Weight = 10 // ZIL SIZE
 	jade.Flags.IsTreasure = true
 	jade.Value = 5
 	g.Items["jade"] = jade
@@ -61,6 +64,7 @@ func createTreasures(g *GameV2) {
 	coins.Aliases = []string{"coins", "bag", "bag-of-coins", "treasure", "leather"}
 	coins.RoomDescription = "An old leather bag, bulging with coins, is here."
 	coins.Flags.IsTakeable = true
+	coins.Weight = 15 // ZIL SIZE
 	coins.Flags.IsTreasure = true
 	coins.Value = 5
 	g.Items["coins"] = coins
@@ -72,6 +76,7 @@ func createTreasures(g *GameV2) {
 	painting.Location = "gallery"
 	painting.RoomDescription = "A painting by a neglected genius is here."
 	painting.Flags.IsTakeable = true
+	painting.Weight = 15 // ZIL SIZE
 	painting.Flags.IsTreasure = true
 	painting.Flags.IsBurnable = true // BURNBIT in ZIL
 	painting.Value = 6 // ZIL: 6
@@ -82,8 +87,10 @@ func createTreasures(g *GameV2) {
 	bracelet.Aliases = []string{"bracelet", "sapphire", "treasure", "jewel"}
 	bracelet.RoomDescription = "There is a sapphire-encrusted bracelet here."
 	bracelet.Flags.IsTakeable = true
+	bracelet.Weight = 10 // ZIL SIZE
 	bracelet.Flags.IsTreasure = true
 	bracelet.Value = 5
+	bracelet.Location = "gas-room"
 	g.Items["bracelet"] = bracelet
 
 	// BAUBLE (brass bauble) - ZIL TVALUE 1
@@ -100,6 +107,7 @@ func createTreasures(g *GameV2) {
 	scarab.Aliases = []string{"scarab", "treasure"}
 	scarab.RoomDescription = "There is a beautiful scarab here."
 	scarab.Flags.IsTakeable = true
+	scarab.Weight = 8 // ZIL SIZE
 	scarab.Flags.IsTreasure = true
 	scarab.Value = 5
 	g.Items["scarab"] = scarab
@@ -110,6 +118,7 @@ func createTreasures(g *GameV2) {
 	pot.Location = "end-of-rainbow"
 	pot.RoomDescription = "At the end of the rainbow is a pot of gold."
 	pot.Flags.IsTakeable = true
+	pot.Weight = 15 // ZIL SIZE
 	pot.Flags.IsTreasure = true
 	pot.Flags.IsInvisible = true // Invisible until rainbow is solidified
 	pot.Value = 10
@@ -119,9 +128,10 @@ func createTreasures(g *GameV2) {
 	// TRIDENT - ZIL TVALUE 11, FDESC from ZIL
 	trident := NewItem("trident", "crystal trident", "It's Poseidon's own crystal trident, a weapon of great power.")
 	trident.Aliases = []string{"trident", "crystal", "treasure", "fork", "poseidon"}
-	trident.Location = "falls"
+	trident.Location = "atlantis-room"
 	trident.RoomDescription = "On the shore lies Poseidon's own crystal trident."
 	trident.Flags.IsTakeable = true
+	trident.Weight = 20 // ZIL SIZE
 	trident.Flags.IsTreasure = true
 	trident.Flags.IsWeapon = true
 	trident.Value = 11 // ZIL: 11
@@ -132,6 +142,7 @@ func createTreasures(g *GameV2) {
 	sceptre.Aliases = []string{"sceptre", "scepter", "treasure"}
 	sceptre.RoomDescription = "There is a sceptre, probably that of ancient Egypt itself, here."
 	sceptre.Flags.IsTakeable = true
+	sceptre.Weight = 3 // ZIL SIZE
 	sceptre.Flags.IsTreasure = true
 	sceptre.Flags.IsWeapon = true
 	sceptre.Value = 6 // ZIL: 6
@@ -144,6 +155,7 @@ func createTreasures(g *GameV2) {
 	egg.RoomDescription = "There is a jewel-encrusted egg here."
 	egg.Flags.IsTakeable = true
 	egg.Flags.IsContainer = true
+	egg.Capacity = 6 // ZIL CAPACITY
 	egg.Flags.IsTreasure = true
 	egg.Value = 5
 	g.Items["egg"] = egg
@@ -153,8 +165,11 @@ func createTreasures(g *GameV2) {
 	platinumBar.Aliases = []string{"bar", "platinum", "platinum-bar", "treasure", "large"}
 	platinumBar.RoomDescription = "On the ground is a large platinum bar."
 	platinumBar.Flags.IsTakeable = true
+	platinumBar.Weight = 20 // ZIL SIZE
 	platinumBar.Flags.IsTreasure = true
 	platinumBar.Value = 5 // ZIL: 5
+	platinumBar.Location = "loud-room"
+	platinumBar.Flags.IsSacred = true // Until the acoustics change
 	g.Items["platinum-bar"] = platinumBar
 	g.Items["bar"] = platinumBar // ZIL uses BAR
 
@@ -172,9 +187,11 @@ func createTreasures(g *GameV2) {
 	ivoryTorch.Aliases = []string{"ivory-torch", "ivory", "torch", "treasure", "flaming"}
 	ivoryTorch.RoomDescription = "Sitting on the pedestal is a flaming torch, made of ivory."
 	ivoryTorch.Flags.IsTakeable = true
+	ivoryTorch.Weight = 20 // ZIL SIZE
 	ivoryTorch.Flags.IsTreasure = true
 	ivoryTorch.Flags.IsLightSource = true
 	ivoryTorch.Flags.IsLit = true
+	ivoryTorch.Flags.IsFlame = true
 	ivoryTorch.Fuel = -1 // Eternal flame, never burns out
 	ivoryTorch.Value = 6
 	g.Items["ivory-torch"] = ivoryTorch
@@ -183,7 +200,9 @@ func createTreasures(g *GameV2) {
 	trunkOfJewels := NewItem("trunk-of-jewels", "trunk of jewels", "The old trunk is bulging with assorted jewels.")
 	trunkOfJewels.Aliases = []string{"trunk", "jewels", "trunk-of-jewels", "treasure", "old"}
 	trunkOfJewels.RoomDescription = "There is an old trunk here, bulging with assorted jewels."
+	trunkOfJewels.Location = "reservoir"
 	trunkOfJewels.Flags.IsTakeable = true
+	trunkOfJewels.Weight = 35 // ZIL SIZE
 	trunkOfJewels.Flags.IsTreasure = true
 	trunkOfJewels.Flags.IsContainer = true
 	trunkOfJewels.Flags.IsInvisible = true // INVISIBLE in ZIL initially
@@ -211,6 +230,7 @@ func createWeapons(g *GameV2) {
 	sword.RoomDescription = "Above the trophy case hangs an elvish sword of great antiquity."
 	sword.Location = "living-room"
 	sword.Flags.IsTakeable = true
+	sword.Weight = 30 // ZIL SIZE
 	sword.Flags.IsWeapon = true
 	g.Items["sword"] = sword
 	g.Rooms["living-room"].AddItem("sword")
@@ -229,6 +249,7 @@ func createWeapons(g *GameV2) {
 	rustyKnife.Aliases = []string{"rusty-knife", "knife", "rusty", "knives"}
 	rustyKnife.RoomDescription = "Beside the skeleton is a rusty knife."
 	rustyKnife.Flags.IsTakeable = true
+	rustyKnife.Weight = 20 // ZIL SIZE
 	rustyKnife.Flags.IsWeapon = true
 	g.Items["rusty-knife"] = rustyKnife
 
@@ -236,6 +257,7 @@ func createWeapons(g *GameV2) {
 	stiletto := NewItem("stiletto", "stiletto", "There is a wicked-looking stiletto here.")
 	stiletto.Aliases = []string{"stiletto", "dagger"}
 	stiletto.Flags.IsTakeable = true
+	stiletto.Weight = 10 // ZIL SIZE
 	stiletto.Flags.IsWeapon = true
 	g.Items["stiletto"] = stiletto
 
@@ -243,6 +265,7 @@ func createWeapons(g *GameV2) {
 	axe := NewItem("axe", "bloody axe", "")
 	axe.Aliases = []string{"axe", "ax"}
 	axe.Flags.IsTakeable = true
+	axe.Weight = 25 // ZIL SIZE
 	axe.Flags.IsWeapon = true
 	// No TEXT property in ZIL - examine gives default message
 	g.Items["axe"] = axe
@@ -254,23 +277,30 @@ func createTools(g *GameV2) {
 	pump := NewItem("pump", "air pump", "There is a hand-held air pump here.")
 	pump.Aliases = []string{"pump", "air-pump"}
 	pump.Flags.IsTakeable = true
+	pump.Location = "reservoir-north"
 	g.Items["pump"] = pump
 
 	// SCREWDRIVER
 	screwdriver := NewItem("screwdriver", "screwdriver", "There is a screwdriver here.")
 	screwdriver.Aliases = []string{"screwdriver"}
+	screwdriver.Location = "maintenance-room"
 	screwdriver.Flags.IsTakeable = true
+	screwdriver.Flags.IsTool = true
 	g.Items["screwdriver"] = screwdriver
 
 	// WRENCH
 	wrench := NewItem("wrench", "wrench", "There is a wrench here.")
-	wrench.Aliases = []string{"wrench"}
+	wrench.Aliases = []string{"wrench", "spanner"}
+	wrench.Location = "maintenance-room"
 	wrench.Flags.IsTakeable = true
+	wrench.Flags.IsTool = true
+	wrench.Weight = 10 // ZIL SIZE
 	g.Items["wrench"] = wrench
 
-	// PUTTY
-	putty := NewItem("putty", "putty", "There is a tube of putty here.")
-	putty.Aliases = []string{"putty", "tube"}
+	// PUTTY (in the tube)
+	putty := NewItem("putty", "viscous material", "There is some gooey material here.")
+	putty.Aliases = []string{"putty", "material", "gunk", "glue", "viscous"}
+	putty.Location = "tube"
 	putty.Flags.IsTakeable = true
 	g.Items["putty"] = putty
 
@@ -278,6 +308,8 @@ func createTools(g *GameV2) {
 	shovel := NewItem("shovel", "shovel", "There is a shovel here.")
 	shovel.Aliases = []string{"shovel", "spade"}
 	shovel.Flags.IsTakeable = true
+	shovel.Flags.IsTool = true
+	shovel.Weight = 15 // ZIL SIZE
 	g.Items["shovel"] = shovel
 
 	// ROPE - FDESC from ZIL, large coil in attic corner
@@ -286,6 +318,7 @@ func createTools(g *GameV2) {
 	rope.Location = "attic"
 	rope.RoomDescription = "A large coil of rope is lying in the corner."
 	rope.Flags.IsTakeable = true
+	rope.Weight = 10 // ZIL SIZE
 	g.Items["rope"] = rope
 }
 
@@ -296,6 +329,7 @@ func createContainers(g *GameV2) {
 	mailbox.Aliases = []string{"mailbox", "box"}
 	mailbox.Location = "west-of-house"
 	mailbox.Flags.IsContainer = true
+	mailbox.Capacity = 10 // ZIL CAPACITY
 	mailbox.Flags.IsOpen = true
 	mailbox.Flags.IsTransparent = true
 	g.Items["mailbox"] = mailbox
@@ -306,6 +340,7 @@ func createContainers(g *GameV2) {
 	trophyCase.Aliases = []string{"case", "trophy-case", "trophy"}
 	trophyCase.Location = "living-room"
 	trophyCase.Flags.IsContainer = true
+	trophyCase.Capacity = 10000 // ZIL CAPACITY
 	trophyCase.Flags.IsOpen = false
 	trophyCase.Flags.IsTransparent = true
 	trophyCase.Flags.NoRoomListing = true // NDESCBIT - don't list separately, it's mentioned in room desc
@@ -319,6 +354,7 @@ func createContainers(g *GameV2) {
 	bottle.RoomDescription = "A bottle is sitting on the table."
 	bottle.Flags.IsTakeable = true
 	bottle.Flags.IsContainer = true
+	bottle.Capacity = 4 // ZIL CAPACITY
 	bottle.Flags.IsTransparent = true
 	g.Items["bottle"] = bottle
 
@@ -328,7 +364,9 @@ func createContainers(g *GameV2) {
 	coffin.Location = "egypt-room"
 	coffin.RoomDescription = "The solid-gold coffin used for the burial of Ramses II is here."
 	coffin.Flags.IsTakeable = true
+	coffin.Weight = 55 // ZIL SIZE
 	coffin.Flags.IsContainer = true
+	coffin.Capacity = 35 // ZIL CAPACITY
 	coffin.Flags.IsOpen = false
 	coffin.Flags.IsTreasure = true
 	coffin.Value = 15 // ZIL: 15
@@ -339,7 +377,9 @@ func createContainers(g *GameV2) {
 	bag := NewItem("sandwich-bag", "brown bag", "There is a brown bag here.")
 	bag.Aliases = []string{"bag", "brown-bag", "sandwich-bag"}
 	bag.Flags.IsTakeable = true
+	bag.Weight = 9 // ZIL SIZE
 	bag.Flags.IsContainer = true
+	bag.Capacity = 9 // ZIL CAPACITY
 	g.Items["sandwich-bag"] = bag
 
 	// LARGE BAG (for carrying treasures)
@@ -356,6 +396,7 @@ func createContainers(g *GameV2) {
 	nest.RoomDescription = "Beside you on the branch is a small bird's nest."
 	nest.Flags.IsTakeable = true
 	nest.Flags.IsContainer = true
+	nest.Capacity = 20 // ZIL CAPACITY
 	nest.Flags.IsOpen = true
 	g.Items["nest"] = nest
 
@@ -367,10 +408,13 @@ func createContainers(g *GameV2) {
 	g.Items["tool-chest"] = toolChest
 
 	// TUBE (for putty)
-	tube := NewItem("tube", "tube", "There is a small tube here.")
-	tube.Aliases = []string{"tube"}
+	tube := NewItem("tube", "tube", "There is an object which looks like a tube of toothpaste here.")
+	tube.Aliases = []string{"tube", "toothpaste"}
+	tube.Location = "maintenance-room"
 	tube.Flags.IsTakeable = true
+	tube.Weight = 5 // ZIL SIZE
 	tube.Flags.IsContainer = true
+	tube.Capacity = 7 // ZIL CAPACITY
 	tube.Flags.IsReadable = true
 	tube.Text = `---> Frobozz Magic Gunk Company <---|
 	  All-Purpose Gunk`
@@ -394,6 +438,7 @@ ZORK is a game of adventure, danger, and low cunning. In it you will explore som
 	leaflet.Location = "mailbox"
 	leaflet.RoomDescription = "A small leaflet is on the ground."
 	leaflet.Flags.IsTakeable = true
+	leaflet.Weight = 2 // ZIL SIZE
 	leaflet.Flags.IsReadable = true
 	g.Items["leaflet"] = leaflet
 
@@ -401,6 +446,7 @@ ZORK is a game of adventure, danger, and low cunning. In it you will explore som
 	book := NewItem("book", "black book", "On the altar is a large black book, open to page 569.")
 	book.Aliases = []string{"book", "prayer-book", "prayer", "black", "black-book"}
 	book.Flags.IsTakeable = true
+	book.Weight = 10 // ZIL SIZE
 	book.Flags.IsReadable = true
 	book.Flags.IsContainer = false // Not a container
 	book.Flags.IsOpen = true        // Always open to page 569
@@ -459,9 +505,10 @@ of FCD#3 as we conduct you on a guided tour of the facilities:
 on your right that....`
 	g.Items["guide"] = guide
 
-	// MAP
-	mapItem := NewItem("map", "map", "There is a map here.")
-	mapItem.Aliases = []string{"map"}
+	// MAP - Nowhere until every treasure is in the trophy case (see endgame.go)
+	mapItem := NewItem("map", "ancient map", "There is an ancient map here.")
+	mapItem.Aliases = []string{"map", "parchment", "ancient", "antique", "old"}
+	mapItem.Weight = 2 // ZIL SIZE
 	mapItem.Flags.IsTakeable = true
 	mapItem.Flags.IsReadable = true
 	mapItem.Text = `The map shows a forest with three clearings. The largest clearing contains
@@ -473,6 +520,8 @@ southwest, is marked "To Stone Barrow".`
 	boatLabel := NewItem("boat-label", "boat label", "There is a label on the boat.")
 	boatLabel.Aliases = []string{"label", "boat-label"}
 	boatLabel.Flags.IsTakeable = true
+	boatLabel.Weight = 2 // ZIL SIZE
+	boatLabel.Location = "boat"
 	boatLabel.Flags.IsReadable = true
 	boatLabel.Text = `  !!!!FROBOZZ MAGIC BOAT COMPANY!!!!
 
@@ -494,11 +543,16 @@ Warning:
    Good Luck!`
 	g.Items["boat-label"] = boatLabel
 
-	// MATCH (matchbook)
+	// MATCH (matchbook) - FDESC from ZIL; MatchCount and Fuel track the matches
 	match := NewItem("match", "matchbook", "There is a matchbook here.")
-	match.Aliases = []string{"match", "matchbook", "matches"}
+	match.Aliases = []string{"match", "matchbook", "matches", "book-of-matches"}
+	match.Location = "dam-lobby"
+	match.RoomDescription = "There is a matchbook whose cover says \"Visit Beautiful FCD#3\" here."
 	match.Flags.IsTakeable = true
+	match.Weight = 2 // ZIL SIZE
 	match.Flags.IsReadable = true
+	match.Flags.IsLightSource = true
+	match.Flags.IsFlame = true
 	match.Text = `(Close cover before striking)
 
 YOU too can make BIG MONEY in the exciting field of PAPER SHUFFLING!
@@ -537,6 +591,7 @@ func createLightSources(g *GameV2) {
 	lamp.RoomDescription = "A battery-powered brass lantern is on the trophy case."
 	lamp.Location = "living-room"
 	lamp.Flags.IsTakeable = true
+	lamp.Weight = 15 // ZIL SIZE
 	lamp.Flags.IsLightSource = true
 	lamp.Flags.IsLit = true
 	lamp.Fuel = 330 // Total turns before lamp dies
@@ -546,20 +601,24 @@ func createLightSources(g *GameV2) {
 	// TORCH
 	torch := NewItem("torch", "torch", "There is a burning torch here.")
 	torch.Aliases = []string{"torch"}
-	torch.Location = "temple"
+	torch.Location = "torch-room"
 	torch.Flags.IsTakeable = true
+	torch.Weight = 20 // ZIL SIZE
 	torch.Flags.IsLightSource = true
 	torch.Flags.IsLit = true
+	torch.Flags.IsFlame = true
 	g.Items["torch"] = torch
 
 	// CANDLES - FDESC from ZIL, burn time from I-CANDLES in ZIL line 2641 (40 turns)
 	candles := NewItem("candles", "pair of candles", "They are burning candles.")
 	candles.Aliases = []string{"candles", "candle", "pair", "burning"}
-	candles.Location = "entrance-to-hades"
+	candles.Location = "south-temple"
 	candles.RoomDescription = "On the two ends of the altar are burning candles."
 	candles.Flags.IsTakeable = true
+	candles.Weight = 10 // ZIL SIZE
 	candles.Flags.IsLightSource = true
 	candles.Flags.IsLit = false
+	candles.Flags.IsFlame = true
 	candles.Fuel = 40 // Burns for 40 turns when lit
 	g.Items["candles"] = candles
 
@@ -567,6 +626,7 @@ func createLightSources(g *GameV2) {
 	burnedLamp := NewItem("burned-out-lantern", "burned-out lantern", "There is a burned-out lantern here.")
 	burnedLamp.Aliases = []string{"burned-out-lantern", "lantern"}
 	burnedLamp.Flags.IsTakeable = true
+	burnedLamp.Weight = 15 // ZIL SIZE
 	g.Items["burned-out-lantern"] = burnedLamp
 }
 
@@ -584,6 +644,7 @@ func createFoodAndDrink(g *GameV2) {
 	garlic := NewItem("garlic", "clove of garlic", "There is a clove of garlic here.")
 	garlic.Aliases = []string{"garlic", "clove"}
 	garlic.Flags.IsTakeable = true
+	garlic.Weight = 4 // ZIL SIZE
 	garlic.Flags.IsEdible = true
 	g.Items["garlic"] = garlic
 
@@ -591,6 +652,7 @@ func createFoodAndDrink(g *GameV2) {
 	water := NewItem("water", "quantity of water", "There is some water here.")
 	water.Aliases = []string{"water", "quantity"}
 	water.Flags.IsTakeable = true
+	water.Weight = 4 // ZIL SIZE
 	water.Flags.IsDrinkable = true
 	g.Items["water"] = water
 }
@@ -671,14 +733,14 @@ func createFixedObjects(g *GameV2) {
 	// ALTAR
 	altar := NewItem("altar", "altar", "There is a marble altar here.")
 	altar.Aliases = []string{"altar"}
-	altar.Location = "temple"
+	altar.Location = "south-temple"
 	altar.Flags.IsTakeable = false
 	g.Items["altar"] = altar
 
 	// BELL (in belfry)
 	bell := NewItem("bell", "bell", "There is a large bell here.")
 	bell.Aliases = []string{"bell"}
-	bell.Location = "belfry"
+	bell.Location = "north-temple"
 	bell.Flags.IsTakeable = true
 	g.Items["bell"] = bell
 
@@ -688,6 +750,7 @@ func createFixedObjects(g *GameV2) {
 	machine.Location = "machine-room"
 	machine.Flags.IsTakeable = false
 	machine.Flags.IsContainer = true
+	machine.Capacity = 50 // ZIL CAPACITY
 	g.Items["machine"] = machine
 	g.Rooms["machine-room"].AddItem("machine")
 
@@ -744,9 +807,12 @@ func createSceneryObjects(g *GameV2) {
 	// RAINBOW
 	rainbow := NewItem("rainbow", "rainbow", "The rainbow seems to have its foot in the vicinity of the building.")
 	rainbow.Aliases = []string{"rainbow"}
-	rainbow.Location = "canyon-view"
+	rainbow.Location = "GLOBAL"
 	rainbow.Flags.IsTakeable = false
 	g.Items["rainbow"] = rainbow
+	g.Rooms["canyon-view"].AddItem("rainbow")
+	g.Rooms["aragain-falls"].AddItem("rainbow")
+	g.Rooms["end-of-rainbow"].AddItem("rainbow")
 
 	// RIVER
 	river := NewItem("river", "river", "The Frigid River flows through here.")
@@ -796,6 +862,7 @@ excised them.`
 	kitchenTable.Location = "kitchen"
 	kitchenTable.Flags.IsTakeable = false
 	kitchenTable.Flags.IsContainer = true
+	kitchenTable.Capacity = 50 // ZIL CAPACITY
 	kitchenTable.Flags.IsOpen = true
 	kitchenTable.Flags.NoRoomListing = true // NDESCBIT - mentioned in room description
 	g.Items["kitchen-table"] = kitchenTable
@@ -807,6 +874,7 @@ excised them.`
 	atticTable.Location = "attic"
 	atticTable.Flags.IsTakeable = false
 	atticTable.Flags.IsContainer = true
+	atticTable.Capacity = 40 // ZIL CAPACITY
 	atticTable.Flags.IsOpen = true
 	atticTable.Flags.NoRoomListing = true // NDESCBIT
 	g.Items["attic-table"] = atticTable
@@ -888,17 +956,21 @@ excised them.`
 	// BARROW-DOOR - At stone-barrow
 	barrowDoor := NewItem("barrow-door", "stone door", "It's a huge stone door.")
 	barrowDoor.Aliases = []string{"door", "huge", "stone", "barrow-door"}
+	barrowDoor.Location = "stone-barrow"
 	barrowDoor.Flags.IsTakeable = false
 	barrowDoor.Flags.IsOpen = true
 	barrowDoor.Flags.NoRoomListing = true // NDESCBIT
 	g.Items["barrow-door"] = barrowDoor
+	g.Rooms["stone-barrow"].AddItem("barrow-door")
 
 	// BARROW - At stone-barrow
 	barrow := NewItem("barrow", "stone barrow", "The barrow is a massive stone structure.")
 	barrow.Aliases = []string{"barrow", "tomb", "massive", "stone"}
+	barrow.Location = "stone-barrow"
 	barrow.Flags.IsTakeable = false
 	barrow.Flags.NoRoomListing = true // NDESCBIT
 	g.Items["barrow"] = barrow
+	g.Rooms["stone-barrow"].AddItem("barrow")
 
 	// Additional scenery objects from ZIL
 
@@ -928,21 +1000,25 @@ excised them.`
 
 	// DAM - DAM-ROOM scenery
 	dam := NewItem("dam", "dam", "The dam is a massive structure controlling the flow of water.")
-	dam.Aliases = []string{"dam", "structure"}
+	dam.Aliases = []string{"dam", "structure", "gate", "gates", "sluice"}
+	dam.Location = "dam-room"
 	dam.Flags.IsTakeable = false
 	dam.Flags.NoRoomListing = true
 	g.Items["dam"] = dam
 
 	// LEAK - MAINTENANCE-ROOM scenery
 	leak := NewItem("leak", "leak", "There's a small leak dripping water.")
-	leak.Aliases = []string{"leak", "drip"}
+	leak.Aliases = []string{"leak", "drip", "pipe"}
+	leak.Location = "maintenance-room"
 	leak.Flags.IsTakeable = false
+	leak.Flags.IsInvisible = true // Until the blue button bursts the pipe
 	leak.Flags.NoRoomListing = true
 	g.Items["leak"] = leak
 
 	// CONTROL-PANEL - DAM-ROOM scenery
 	controlPanel := NewItem("control-panel", "control panel", "The control panel has various buttons and switches.")
 	controlPanel.Aliases = []string{"control-panel", "panel", "controls"}
+	controlPanel.Location = "dam-room"
 	controlPanel.Flags.IsTakeable = false
 	controlPanel.Flags.NoRoomListing = true
 	g.Items["control-panel"] = controlPanel
@@ -957,19 +1033,24 @@ excised them.`
 	// BOLT - DAM-ROOM
 	bolt := NewItem("bolt", "bolt", "It's a large metal bolt.")
 	bolt.Aliases = []string{"bolt"}
-	bolt.Flags.IsTakeable = true
+	bolt.Location = "dam-room"
+	bolt.Flags.IsTakeable = false
+	bolt.Flags.NoRoomListing = true
 	g.Items["bolt"] = bolt
 
 	// BUBBLE - DAM-ROOM
 	bubble := NewItem("bubble", "green bubble", "It's a strange green bubble.")
 	bubble.Aliases = []string{"bubble", "green"}
+	bubble.Location = "dam-room"
 	bubble.Flags.IsTakeable = false
+	bubble.Flags.NoRoomListing = true
 	g.Items["bubble"] = bubble
 
 	// BROKEN-LAMP
 	brokenLamp := NewItem("broken-lamp", "broken lantern", "The lantern is broken and useless.")
 	brokenLamp.Aliases = []string{"broken-lamp", "broken", "lantern"}
 	brokenLamp.Flags.IsTakeable = true
+	brokenLamp.Weight = 15 // ZIL SIZE
 	g.Items["broken-lamp"] = brokenLamp
 
 	// HOT-BELL
@@ -982,6 +1063,7 @@ excised them.`
 	gunk := NewItem("gunk", "small piece of vitreous slag", "It's a small, glassy piece of slag.")
 	gunk.Aliases = []string{"gunk", "slag", "vitreous"}
 	gunk.Flags.IsTakeable = true
+	gunk.Weight = 10 // ZIL SIZE
 	g.Items["gunk"] = gunk
 
 	// NOTE: NPCs (troll, thief, cyclops, bat, ghosts) are NOT items.
@@ -992,24 +1074,18 @@ excised them.`
 
 // createMiscItems creates various other items
 func createMiscItems(g *GameV2) {
-	// INFLATABLE BOAT (also INFLATABLE-BOAT in ZIL)
+	// BOAT - starts deflated; setBoatState turns it into the inflated magic
+	// boat or the punctured one (INFLATABLE-BOAT, INFLATED-BOAT and
+	// PUNCTURED-BOAT in ZIL)
 	boat := NewItem("boat", "inflatable boat", "There is an inflatable boat here.")
-	boat.Aliases = []string{"boat", "inflatable-boat", "raft", "pile", "plastic"}
+	boat.Aliases = []string{"boat", "inflatable-boat", "inflated-boat", "punctured-boat", "raft", "pile", "plastic", "magic"}
 	boat.Flags.IsTakeable = true
+	boat.Capacity = 100 // ZIL CAPACITY
+	boat.Weight = 20 // ZIL SIZE
+	boat.Location = "dam-base"
 	g.Items["boat"] = boat
 	g.Items["inflatable-boat"] = boat // ZIL uses INFLATABLE-BOAT
-
-	// INFLATED BOAT (boat when inflated)
-	inflatedBoat := NewItem("inflated-boat", "inflated boat", "There is an inflated boat here.")
-	inflatedBoat.Aliases = []string{"boat", "inflated-boat"}
-	inflatedBoat.Flags.IsTakeable = true
-	g.Items["inflated-boat"] = inflatedBoat
-
-	// PUNCTURED BOAT
-	puncturedBoat := NewItem("punctured-boat", "punctured boat", "There is a punctured boat here.")
-	puncturedBoat.Aliases = []string{"boat", "punctured-boat"}
-	puncturedBoat.Flags.IsTakeable = true
-	g.Items["punctured-boat"] = puncturedBoat
+	g.setBoatState(boatDeflated)
 
 	// SKULL - ZIL TVALUE 10 (treasure!) - crystal skull from LAND-OF-LIVING-DEAD
 	skull := NewItem("skull", "crystal skull", "The crystal skull is beautifully carved and grinning rather nastily.")
@@ -1029,14 +1105,16 @@ func createMiscItems(g *GameV2) {
 	// COAL
 	coal := NewItem("coal", "pile of coal", "There is a pile of coal here.")
 	coal.Aliases = []string{"coal", "pile"}
-	coal.Location = "coal-mine-4"
+	coal.Location = "dead-end-5"
 	coal.Flags.IsTakeable = true
+	coal.Weight = 20 // ZIL SIZE
 	g.Items["coal"] = coal
 
 	// TIMBER
 	timbers := NewItem("timbers", "timber", "There are timber supports here.")
 	timbers.Aliases = []string{"timber", "timbers"}
 	timbers.Flags.IsTakeable = true
+	timbers.Weight = 50 // ZIL SIZE
 	g.Items["timbers"] = timbers
 
 	// LADDER
@@ -1045,54 +1123,55 @@ func createMiscItems(g *GameV2) {
 	ladder.Flags.IsTakeable = true
 	g.Items["ladder"] = ladder
 
-	// CANARY (bird)
-	canary := NewItem("canary", "canary", "There is a canary here, singing cheerfully.")
-	canary.Aliases = []string{"canary", "bird"}
+	// CANARY (clockwork canary, inside the egg) - ZIL TVALUE 4
+	canary := NewItem("canary", "golden clockwork canary", "There is a golden clockwork canary here.")
+	canary.Aliases = []string{"canary", "bird", "clockwork", "golden", "treasure"}
+	canary.Location = "egg"
 	canary.Flags.IsTakeable = true
+	canary.Flags.IsTreasure = true
+	canary.Value = 4 // ZIL: 4
 	g.Items["canary"] = canary
 
-	// BROKEN CANARY
-	brokenCanary := NewItem("broken-canary", "broken canary", "There is a dead canary here.")
-	brokenCanary.Aliases = []string{"canary", "bird", "broken-canary"}
+	// BROKEN CANARY (inside the broken egg) - ZIL TVALUE 1
+	brokenCanary := NewItem("broken-canary", "broken clockwork canary", "There is a golden clockwork canary here. It seems to have recently had a bad experience.")
+	brokenCanary.Aliases = []string{"canary", "bird", "broken-canary", "clockwork", "broken", "treasure"}
+	brokenCanary.Location = "broken-egg"
 	brokenCanary.Flags.IsTakeable = true
+	brokenCanary.Flags.IsTreasure = true
+	brokenCanary.Value = 1 // ZIL: 1
 	g.Items["broken-canary"] = brokenCanary
 
-	// BROKEN EGG
-	brokenEgg := NewItem("broken-egg", "broken egg", "There is a broken jeweled egg here.")
-	brokenEgg.Aliases = []string{"egg", "broken-egg"}
+	// BROKEN EGG (out of play until the egg is forced) - ZIL TVALUE 2
+	brokenEgg := NewItem("broken-egg", "broken jewel-encrusted egg", "There is a somewhat ruined egg here.")
+	brokenEgg.Aliases = []string{"egg", "broken-egg", "broken", "treasure"}
 	brokenEgg.Flags.IsTakeable = true
 	brokenEgg.Flags.IsContainer = true
+	brokenEgg.Capacity = 6 // ZIL CAPACITY
 	brokenEgg.Flags.IsOpen = true
+	brokenEgg.Flags.IsTreasure = true
+	brokenEgg.Value = 2 // ZIL: 2
 	g.Items["broken-egg"] = brokenEgg
 
 	// BUOY
 	buoy := NewItem("buoy", "red buoy", "There is a red buoy here (probably a warning).")
 	buoy.Aliases = []string{"buoy", "red-buoy"}
-	buoy.Location = "reservoir-south"
+	buoy.Location = "river-4"
 	buoy.Flags.IsTakeable = false
 	g.Items["buoy"] = buoy
 
-	// RAISED BASKET (starts at shaft-room, raised to ceiling) - LDESC from ZIL
-	raisedBasket := NewItem("raised-basket", "basket", "It's a wicker basket suspended from a chain.")
-	raisedBasket.Aliases = []string{"basket", "wicker-basket", "raised-basket", "cage", "dumbwaiter"}
-	raisedBasket.Location = "shaft-room"
-	raisedBasket.RoomDescription = "At the end of the chain is a basket."
-	raisedBasket.Flags.IsTakeable = false
-	raisedBasket.Flags.IsContainer = true
-	raisedBasket.Flags.IsOpen = true
-	raisedBasket.Flags.IsTransparent = true
-	g.Items["raised-basket"] = raisedBasket
-	g.Rooms["shaft-room"].AddItem("raised-basket")
-
-	// LOWERED BASKET (appears when basket is lowered) - LDESC from ZIL
-	loweredBasket := NewItem("lowered-basket", "basket", "It's a wicker basket suspended from a chain.")
-	loweredBasket.Aliases = []string{"basket", "wicker-basket", "lowered-basket", "cage", "dumbwaiter"}
-	loweredBasket.RoomDescription = "From the chain is suspended a basket."
-	loweredBasket.Flags.IsTakeable = false
-	loweredBasket.Flags.IsContainer = true
-	loweredBasket.Flags.IsOpen = true
-	loweredBasket.Flags.IsTransparent = true
-	g.Items["lowered-basket"] = loweredBasket
+	// BASKET (RAISED-BASKET in ZIL) - carries things between the shaft room
+	// and the bottom of the shaft
+	basket := NewItem("basket", "basket", "It's a wicker basket suspended from a chain.")
+	basket.RoomDescription = "At the end of the chain is a basket."
+	basket.Aliases = []string{"basket", "wicker-basket", "cage", "dumbwaiter"}
+	basket.Location = "shaft-room"
+	basket.Flags.IsTakeable = false
+	basket.Flags.IsContainer = true
+	basket.Capacity = 50 // ZIL CAPACITY
+	basket.Flags.IsOpen = true
+	basket.Flags.IsTransparent = true
+	basket.Vehicle = &Vehicle{}
+	g.Items["basket"] = basket
 
 	// PRAYER (of protection)
 	prayer := NewItem("prayer", "prayer", "The prayer seems to be a plea for protection.")
@@ -1111,6 +1190,7 @@ Zorkers were obscure.`
 	keys.Aliases = []string{"keys", "key"}
 	keys.RoomDescription = "There is a set of keys here."
 	keys.Flags.IsTakeable = true
+	keys.Weight = 10 // ZIL SIZE
 	keys.Location = "living-room"
 	g.Items["keys"] = keys
 	g.Rooms["living-room"].AddItem("keys")
@@ -1122,18 +1202,13 @@ Zorkers were obscure.`
 	clam.Flags.IsOpen = true
 	g.Items["clam"] = clam
 
-	// MATCHES (book of matches)
-	matches := NewItem("matches", "book of matches", "There is a book of matches here.")
-	matches.Aliases = []string{"matches", "book-of-matches", "matchbook"}
-	matches.Flags.IsTakeable = true
-	matches.Flags.IsReadable = true
-	g.Items["matches"] = matches
 
 	// MIRROR (in mirror-room-1)
 	mirror1 := NewItem("mirror-1", "mirror", "An enormous mirror fills the south wall.")
 	mirror1.Aliases = []string{"mirror", "looking-glass"}
 	mirror1.Location = "mirror-room-1"
 	mirror1.Flags.IsTakeable = false
+	mirror1.Flags.NoRoomListing = true
 	g.Items["mirror-1"] = mirror1
 	g.Rooms["mirror-room-1"].AddItem("mirror-1")
 
@@ -1142,6 +1217,7 @@ Zorkers were obscure.`
 	mirror2.Aliases = []string{"mirror", "looking-glass"}
 	mirror2.Location = "mirror-room-2"
 	mirror2.Flags.IsTakeable = false
+	mirror2.Flags.NoRoomListing = true
 	g.Items["mirror-2"] = mirror2
 	g.Rooms["mirror-room-2"].AddItem("mirror-2")
 
@@ -1195,12 +1271,25 @@ Zorkers were obscure.`
 	slide.Flags.IsTakeable = false
 	g.Items["slide"] = slide
 
-	// BRICK
-	brick := NewItem("brick", "brick", "There is a brick here.")
+	// BRICK - an explosive that needs a fuse (BRICK in ZIL)
+	brick := NewItem("brick", "brick", "There is a square brick here which feels like clay.")
 	brick.Aliases = []string{"brick"}
+	brick.Location = "dead-end-5"
 	brick.Flags.IsTakeable = true
+	brick.Flags.IsContainer = true
+	brick.Flags.IsOpen = true
+	brick.Weight = 9
+	brick.Capacity = 2
 	g.Items["brick"] = brick
 
+	// FUSE - burns down and sets off the brick (FUSE in ZIL)
+	fuse := NewItem("fuse", "wire fuse", "There is a coil of thin shiny wire here.")
+	fuse.Aliases = []string{"fuse", "wire"}
+	fuse.Location = "brick"
+	fuse.Flags.IsTakeable = true
+	fuse.Weight = 1
+	g.Items["fuse"] = fuse
+
 	// STATUE (ivory and jade)
 	statue := NewItem("statue", "ivory and jade statue", "There is an exquisite statue here.")
 	statue.Aliases = []string{"statue", "idol"}
@@ -0,0 +1,95 @@
+package engine
+
+import "strings"
+
+// The Loud Room (LOUD-ROOM-FCN and LOUD-RUNS in ZIL)
+//
+// The rushing water from the dam fills the Loud Room with noise. While the
+// reservoir is full the din is so bad that the player can do nothing but
+// leave, and everything they say comes back at them. Once the gates are open
+// and the water is pouring through, the roar drives them out altogether.
+// Saying ECHO changes the acoustics for good, and only then can the platinum
+// bar be carried off.
+
+// loudRuns are the rooms the player may scramble into when the roar drives
+// them out (LOUD-RUNS in ZIL)
+var loudRuns = []string{"damp-cave", "round-room", "deep-canyon"}
+
+// loudRoomQuiet reports whether the Loud Room is bearable: the acoustics
+// have been changed, or the reservoir has drained behind closed gates
+func (g *GameV2) loudRoomQuiet() bool {
+	return g.Flags["loud-flag"] || (!g.Flags["dam-open"] && g.Flags["low-tide"])
+}
+
+// loudRoomRoaring reports whether water is rushing through the open gates
+func (g *GameV2) loudRoomRoaring() bool {
+	return g.Flags["dam-open"] && !g.Flags["low-tide"]
+}
+
+// loudRoomAction describes the Loud Room and throws the player out while
+// the water roars (LOUD-ROOM-FCN M-LOOK and M-END in ZIL)
+func loudRoomAction(g *GameV2, event RoomEvent) string {
+	switch event {
+	case EventLook:
+		description := g.Rooms["loud-room"].Description
+		if g.loudRoomQuiet() {
+			return description + " The room is eerie in its quietness."
+		}
+		return description + " The room is deafeningly loud with an undetermined rushing sound. The sound seems to reverberate from all of the walls, making it difficult even to think."
+
+	case EventEnd:
+		if !g.loudRoomRoaring() {
+			return ""
+		}
+		return "It is unbearably loud here, with an ear-splitting roar seeming to come from all around you. There is a pounding in your head which won't stop. With a tremendous effort, you scramble out of the room.\n\n" +
+			g.goTo(loudRuns[g.randomInt(len(loudRuns))])
+	}
+	return ""
+}
+
+// loudRoomFunction handles the player's commands in the Loud Room while it is
+// too noisy to think (LOUD-ROOM-FCN M-ENTER in ZIL). Only leaving, saying
+// ECHO and saving or quitting get through; everything else echoes. It returns
+// false for commands that work as usual.
+func (g *GameV2) loudRoomFunction(cmd *Command) (string, bool) {
+	if cmd.Verb == "echo" {
+		return g.changeAcoustics(), true
+	}
+	if g.loudRoomQuiet() || g.loudRoomRoaring() {
+		return "", false
+	}
+
+	switch cmd.Verb {
+	case "walk":
+		if cmd.Direction == "west" || cmd.Direction == "east" || cmd.Direction == "up" {
+			return "", false
+		}
+	case "save", "restore", "quit":
+		return "", false
+	}
+	return echoLastWord(cmd.Raw), true
+}
+
+// changeAcoustics handles ECHO in the Loud Room. The bar is no longer safe
+// from the thief once the room goes quiet (LOUD-ROOM-FCN in ZIL).
+func (g *GameV2) changeAcoustics() string {
+	if g.loudRoomQuiet() {
+		return g.handleEcho()
+	}
+	g.Flags["loud-flag"] = true
+	if bar := g.Items["platinum-bar"]; bar != nil {
+		bar.Flags.IsSacred = false
+	}
+	return "The acoustics of the room change subtly."
+}
+
+// echoLastWord repeats the last word of the input back at the player
+// (V-ECHO in ZIL)
+func echoLastWord(input string) string {
+	words := strings.Fields(input)
+	if len(words) == 0 {
+		return "echo echo ..."
+	}
+	last := words[len(words)-1]
+	return last + " " + last + " ..."
+}
@@ -0,0 +1,82 @@
+package engine
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+// inLoudRoom puts a player with a lit lamp in the Loud Room
+func inLoudRoom() *GameV2 {
+	g := NewGameV2("test")
+	g.rand = rand.New(rand.NewSource(1))
+	g.Location = "loud-room"
+	g.moveItem("lamp", "inventory")
+	g.Items["lamp"].Flags.IsLit = true
+	return g
+}
+
+func TestLoudRoomIsDeafening(t *testing.T) {
+	g := inLoudRoom()
+	g.Location = "round-room"
+
+	if result := g.Process("east"); !strings.Contains(result, "deafeningly loud") {
+		t.Errorf("Expected a deafening room, got: %s", result)
+	}
+	if result := g.Process("look"); result != "look look ..." {
+		t.Errorf("look = %q, want it echoed", result)
+	}
+}
+
+func TestLoudRoomEchoesUntilAcousticsChange(t *testing.T) {
+	g := inLoudRoom()
+
+	if result := g.Process("take bar"); result != "bar bar ..." {
+		t.Errorf("take bar = %q, want it echoed", result)
+	}
+	if g.Items["platinum-bar"].Location != "loud-room" {
+		t.Fatalf("platinum-bar Location = %q, want it left in the room", g.Items["platinum-bar"].Location)
+	}
+	if g.rob("loud-room", 100); g.Items["platinum-bar"].Location != "loud-room" {
+		t.Errorf("The thief took the bar before the acoustics changed")
+	}
+
+	if result := g.Process("echo"); !strings.Contains(result, "acoustics of the room change") {
+		t.Errorf("echo: %s", result)
+	}
+	if !g.Flags["loud-flag"] {
+		t.Error("loud-flag not set after ECHO")
+	}
+	if result := g.Process("look"); !strings.Contains(result, "eerie in its quietness") {
+		t.Errorf("Expected a quiet room, got: %s", result)
+	}
+	if g.Process("take bar"); g.Items["platinum-bar"].Location != "inventory" {
+		t.Errorf("platinum-bar Location = %q, want inventory", g.Items["platinum-bar"].Location)
+	}
+	if result := g.Process("echo"); result != "echo echo ..." {
+		t.Errorf("echo in the quiet room = %q", result)
+	}
+}
+
+func TestLoudRoomLetsPlayerLeave(t *testing.T) {
+	g := inLoudRoom()
+
+	if g.Process("west"); g.Location != "round-room" {
+		t.Errorf("Location = %q, want round-room", g.Location)
+	}
+}
+
+func TestRoaringWaterThrowsPlayerOut(t *testing.T) {
+	g := inLoudRoom()
+	g.Location = "round-room"
+	g.Flags["dam-open"] = true
+	g.Flags["low-tide"] = false
+
+	result := g.Process("east")
+	if !strings.Contains(result, "scramble out of the room") {
+		t.Errorf("Expected to be driven out, got: %s", result)
+	}
+	if !containsString(loudRuns, g.Location) {
+		t.Errorf("Location = %q, want one of %v", g.Location, loudRuns)
+	}
+}
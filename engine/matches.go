@@ -0,0 +1,129 @@
+package engine
+
+import "fmt"
+
+// The matchbook and the candles (MATCH-FUNCTION, I-MATCH and CANDLES-FCN in
+// ZIL)
+//
+// The matchbook holds only a few matches, and each burns for a couple of
+// turns before going out. Drafty rooms put a match out as soon as it is
+// struck. The candles have no flame of their own: once out, they can only be
+// relit from a burning match.
+
+// matchesInBook is how many matches the matchbook starts with (MATCH-COUNT
+// in ZIL, less the one it counts from)
+const matchesInBook = 5
+
+// matchBurnTurns is how long a struck match burns (I-MATCH in ZIL)
+const matchBurnTurns = 2
+
+// draftyRooms blow a match out as soon as it is struck (MATCH-FUNCTION in
+// ZIL)
+var draftyRooms = []string{"lower-shaft", "timber-room"}
+
+// strikeMatch lights one of the matches in the book (MATCH-FUNCTION in ZIL)
+func (g *GameV2) strikeMatch() string {
+	match := g.Items["match"]
+	if match.Flags.IsLit {
+		return "It's already on."
+	}
+	if g.MatchCount <= 0 {
+		return "I'm afraid that you have run out of matches."
+	}
+	g.MatchCount--
+	if containsString(draftyRooms, g.Location) {
+		return "This room is drafty, and the match goes out instantly."
+	}
+
+	match.Flags.IsLit = true
+	match.Fuel = matchBurnTurns
+	if result := g.gasIgnites(match); result != "" {
+		return result
+	}
+	return "One of the matches begins to burn."
+}
+
+// putOutMatch blows out the burning match (MATCH-FUNCTION in ZIL)
+func (g *GameV2) putOutMatch() string {
+	match := g.Items["match"]
+	if !match.Flags.IsLit {
+		return "It's already off."
+	}
+	match.Flags.IsLit = false
+	match.Fuel = 0
+	if g.inDarkness() {
+		return "The match is out.\nIt's pitch black in here!"
+	}
+	return "The match is out."
+}
+
+// countMatches says how many matches are left (MATCH-FUNCTION in ZIL)
+func (g *GameV2) countMatches() string {
+	switch g.MatchCount {
+	case 0:
+		return "You have no matches."
+	case 1:
+		return "You have 1 match."
+	}
+	return fmt.Sprintf("You have %d matches.", g.MatchCount)
+}
+
+// processMatchFuel lets a struck match burn down (I-MATCH in ZIL)
+func (g *GameV2) processMatchFuel() string {
+	match := g.Items["match"]
+	if match == nil || !match.Flags.IsLit || match.Fuel <= 0 {
+		return ""
+	}
+
+	match.Fuel--
+	if match.Fuel > 0 {
+		return ""
+	}
+	match.Flags.IsLit = false
+	if g.parentOf("match") != "inventory" && g.parentOf("match") != g.Location {
+		return ""
+	}
+	if g.inDarkness() {
+		return "The match has gone out.\nIt is now pitch black."
+	}
+	return "The match has gone out."
+}
+
+// candleFlame decides whether the candles can be lit with the given tool
+// (CANDLES-FCN in ZIL). It returns what to say first and whether to go ahead.
+func (g *GameV2) candleFlame(toolName string) (string, bool) {
+	candles := g.Items["candles"]
+	if candles.Fuel <= 0 {
+		return "Alas, there's not much left of the candles. Certainly not enough to burn.", false
+	}
+
+	match := g.Items["match"]
+	if toolName == "" {
+		if match != nil && match.Flags.IsLit && g.findItem("match") != nil {
+			if candles.Flags.IsLit {
+				return "(with the match)\nThe candles are already lit.", false
+			}
+			return "(with the match)\n", true
+		}
+		return "You should say what to light them with.", false
+	}
+
+	tool := g.findItem(toolName)
+	if tool == nil {
+		return "You can't see any " + toolName + " here.", false
+	}
+	switch {
+	case tool.ID == "match" && tool.Flags.IsLit:
+		if candles.Flags.IsLit {
+			return "The candles are already lit.", false
+		}
+		return "", true
+	case tool.ID == "torch" || tool.ID == "ivory-torch":
+		if candles.Flags.IsLit {
+			return "You realize, just in time, that the candles are already lighted.", false
+		}
+		g.removeItem("candles")
+		return "The heat from the torch is so intense that the candles are vaporized.", false
+	}
+	return "You have to light them with something that's burning, you know.", false
+}
@@ -0,0 +1,84 @@
+package engine
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMatchBurnsDown(t *testing.T) {
+	g := NewGameV2("test")
+	g.Location = "dam-lobby"
+	g.Process("take matchbook")
+
+	if result := g.Process("count matches"); !strings.Contains(result, "You have 5 matches.") {
+		t.Errorf("count matches: %s", result)
+	}
+	if result := g.Process("light match"); !strings.Contains(result, "One of the matches begins to burn.") {
+		t.Fatalf("light match: %s", result)
+	}
+	if g.MatchCount != 4 {
+		t.Errorf("MatchCount = %d, want 4", g.MatchCount)
+	}
+	if result := g.Process("wait"); !strings.Contains(result, "The match has gone out.") {
+		t.Errorf("Expected the match to go out on the next turn, got: %s", result)
+	}
+	if g.Items["match"].Flags.IsLit {
+		t.Error("The match should be out")
+	}
+}
+
+func TestMatchesRunOut(t *testing.T) {
+	g := NewGameV2("test")
+	g.moveItem("match", "inventory")
+
+	g.Location = "timber-room"
+	if result := g.Process("strike match"); !strings.Contains(result, "This room is drafty") {
+		t.Errorf("strike match in the timber room: %s", result)
+	}
+	g.Location = "dam-lobby"
+	for i := 0; i < 4; i++ {
+		g.Process("light match")
+		g.Process("extinguish match")
+	}
+	if result := g.Process("light match"); !strings.Contains(result, "run out of matches") {
+		t.Errorf("light match with none left: %s", result)
+	}
+}
+
+func TestCandlesNeedFlame(t *testing.T) {
+	g := NewGameV2("test")
+	g.Location = "south-temple"
+	g.moveItem("candles", "inventory")
+	g.moveItem("match", "inventory")
+
+	if result := g.Process("light candles"); !strings.Contains(result, "You should say what to light them with.") {
+		t.Errorf("light candles without a flame: %s", result)
+	}
+	if result := g.Process("light candles with match"); !strings.Contains(result, "something that's burning") {
+		t.Errorf("light candles with an unlit match: %s", result)
+	}
+
+	g.Process("light match")
+	result := g.Process("light candles")
+	if !strings.Contains(result, "(with the match)") || !strings.Contains(result, "The candles are lit.") {
+		t.Errorf("light candles with a burning match: %s", result)
+	}
+	if !g.Items["candles"].Flags.IsLit {
+		t.Error("The candles should be lit")
+	}
+}
+
+func TestTurnOnCandlesFindsFlame(t *testing.T) {
+	g := NewGameV2("test")
+	g.Location = "south-temple"
+	g.moveItem("candles", "inventory")
+	g.moveItem("match", "inventory")
+
+	// "flip on candles" leaves the candles in the indirect object slot,
+	// where they must not be taken for the flame
+	g.Process("light match")
+	result := g.Process("flip on candles")
+	if !strings.Contains(result, "(with the match)") || !strings.Contains(result, "The candles are lit.") {
+		t.Errorf("flip on candles with a burning match: %s", result)
+	}
+}
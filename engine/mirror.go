@@ -0,0 +1,101 @@
+package engine
+
+// The mirrors (MIRROR-MIRROR and MIRROR-ROOM in ZIL)
+//
+// The two Mirror Rooms face each other through their mirrors. Rubbing a
+// mirror swaps the rooms' contents, the player included, with a small
+// earthquake. Breaking one brings seven years' bad luck: from then on the
+// game's dangers turn against the player more often.
+
+// isMirror reports whether an item is one of the two great mirrors
+func isMirror(item *Item) bool {
+	return item != nil && (item.ID == "mirror-1" || item.ID == "mirror-2")
+}
+
+// mirrorRoomAction describes a Mirror Room, broken mirror and all
+// (MIRROR-ROOM in ZIL)
+func mirrorRoomAction(g *GameV2, event RoomEvent) string {
+	if event != EventLook || !g.Flags["mirror-mung"] {
+		return ""
+	}
+	return g.Rooms[g.Location].Description + "\nUnfortunately, the mirror has been destroyed by your recklessness."
+}
+
+// rubMirror swaps everything in this Mirror Room, the player included, with
+// the other one (MIRROR-MIRROR in ZIL)
+func (g *GameV2) rubMirror(toolName string) string {
+	if g.Flags["mirror-mung"] {
+		return "You feel nothing unexpected."
+	}
+	if toolName != "" && toolName != "hands" {
+		tool := g.findItem(toolName)
+		if tool == nil {
+			return "You can't see any " + toolName + " here."
+		}
+		return "You feel a faint tingling transmitted through the " + tool.Name + "."
+	}
+
+	here, there := "mirror-room-1", "mirror-room-2"
+	if g.Location == there {
+		here, there = there, here
+	} else if g.Location != here {
+		return "You feel nothing unexpected."
+	}
+
+	// The mirrors are the walls and stay put; everything else changes places
+	var fromHere, fromThere []string
+	for _, id := range g.contentsOf(here) {
+		if !isMirror(g.Items[id]) {
+			fromHere = append(fromHere, id)
+		}
+	}
+	for _, id := range g.contentsOf(there) {
+		if !isMirror(g.Items[id]) {
+			fromThere = append(fromThere, id)
+		}
+	}
+	for _, id := range fromHere {
+		g.moveItem(id, there)
+	}
+	for _, id := range fromThere {
+		g.moveItem(id, here)
+	}
+
+	npcsHere := append([]string{}, g.Rooms[here].NPCs...)
+	npcsThere := append([]string{}, g.Rooms[there].NPCs...)
+	for _, id := range npcsHere {
+		g.moveNPC(g.NPCs[id], there)
+	}
+	for _, id := range npcsThere {
+		g.moveNPC(g.NPCs[id], here)
+	}
+
+	// The player moves with the room and sees nothing new (GOTO without a
+	// description in ZIL)
+	g.Location = there
+	g.Rooms[there].FirstVisit = false
+	return "There is a rumble from deep within the earth and the room shakes."
+}
+
+// breakMirror smashes the mirrors for good (MIRROR-MIRROR in ZIL)
+func (g *GameV2) breakMirror() string {
+	if g.Flags["mirror-mung"] {
+		return "Haven't you done enough damage already?"
+	}
+	g.Flags["mirror-mung"] = true
+	return "You have broken the mirror. I hope you have a seven years' supply of good luck handy."
+}
+
+// unlucky reports whether the player has broken a mirror (LUCKY in ZIL)
+func (g *GameV2) unlucky() bool {
+	return g.Flags["mirror-mung"]
+}
+
+// withLuck adjusts the percent chance of something bad happening to the
+// player: bad luck halves their chance of getting away with it
+func (g *GameV2) withLuck(chance int) int {
+	if !g.unlucky() {
+		return chance
+	}
+	return chance + (100-chance)/2
+}
@@ -0,0 +1,66 @@
+package engine
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRubbingMirrorSwapsRooms(t *testing.T) {
+	g := NewGameV2("test")
+	g.Location = "mirror-room-1"
+	g.moveItem("leaflet", "mirror-room-1")
+	g.moveItem("garlic", "mirror-room-2")
+
+	result := g.Process("rub mirror")
+	if !strings.Contains(result, "rumble from deep within the earth") {
+		t.Errorf("rub mirror: %s", result)
+	}
+	if g.Location != "mirror-room-2" {
+		t.Errorf("Location = %q, want mirror-room-2", g.Location)
+	}
+	if g.Items["leaflet"].Location != "mirror-room-2" || g.Items["garlic"].Location != "mirror-room-1" {
+		t.Errorf("leaflet in %q, garlic in %q; want them swapped",
+			g.Items["leaflet"].Location, g.Items["garlic"].Location)
+	}
+	if g.Items["mirror-1"].Location != "mirror-room-1" || g.Items["mirror-2"].Location != "mirror-room-2" {
+		t.Error("The mirrors should stay on their walls")
+	}
+}
+
+func TestRubbingMirrorWithTool(t *testing.T) {
+	g := NewGameV2("test")
+	g.Location = "mirror-room-1"
+	g.moveItem("sword", "inventory")
+
+	if result := g.Process("rub mirror with sword"); !strings.Contains(result, "faint tingling") {
+		t.Errorf("rub mirror with sword: %s", result)
+	}
+	if g.Location != "mirror-room-1" {
+		t.Errorf("Location = %q, want to stay in mirror-room-1", g.Location)
+	}
+}
+
+func TestBrokenMirrorBringsBadLuck(t *testing.T) {
+	g := NewGameV2("test")
+	g.Location = "mirror-room-1"
+
+	if g.withLuck(grueChance) != grueChance {
+		t.Errorf("withLuck(%d) = %d before breaking the mirror", grueChance, g.withLuck(grueChance))
+	}
+	if result := g.Process("break mirror"); !strings.Contains(result, "seven years' supply") {
+		t.Errorf("break mirror: %s", result)
+	}
+	if !g.unlucky() || g.withLuck(grueChance) != 90 {
+		t.Errorf("withLuck(%d) = %d after breaking the mirror, want 90", grueChance, g.withLuck(grueChance))
+	}
+
+	if result := g.Process("look"); !strings.Contains(result, "destroyed by your recklessness") {
+		t.Errorf("look: %s", result)
+	}
+	if result := g.Process("attack mirror"); !strings.Contains(result, "enough damage already") {
+		t.Errorf("attack mirror: %s", result)
+	}
+	if g.Process("rub mirror"); g.Location != "mirror-room-1" {
+		t.Errorf("A broken mirror still moved the player to %s", g.Location)
+	}
+}
@@ -358,3 +358,83 @@ func TestDeadThiefCorpseVisible(t *testing.T) {
 		t.Errorf("Expected to be able to search dead thief, got: %s", searchResult)
 	}
 }
+
+func TestActorCommandDispatch(t *testing.T) {
+	tests := []struct {
+		name     string
+		setup    func(*GameV2)
+		command  string
+		contains string
+	}{
+		{
+			name:     "troll refuses to hand over axe",
+			setup:    func(g *GameV2) { g.Location = "troll-room" },
+			command:  "troll, give me the axe",
+			contains: "Better luck next time",
+		},
+		{
+			name:     "troll default",
+			setup:    func(g *GameV2) { g.Location = "troll-room" },
+			command:  "troll, wait",
+			contains: "isn't much of a conversationalist",
+		},
+		{
+			name: "thief default",
+			setup: func(g *GameV2) {
+				g.NPCs["thief"].Location = "west-of-house"
+				g.Location = "west-of-house"
+				g.Rooms["west-of-house"].AddNPC("thief")
+			},
+			command:  "thief, go north",
+			contains: "strong, silent type",
+		},
+		{
+			name:     "cyclops default",
+			setup:    func(g *GameV2) { g.Location = "cyclops-room" },
+			command:  "cyclops, wait",
+			contains: "prefers eating",
+		},
+		{
+			name:     "bat has no handler",
+			setup:    func(g *GameV2) { g.Location = "bat-room" },
+			command:  "bat, wait",
+			contains: "pays no attention to you",
+		},
+		{
+			name:     "actor not present",
+			setup:    func(g *GameV2) { g.Location = "west-of-house" },
+			command:  "troll, wait",
+			contains: "can't see any troll here",
+		},
+		{
+			name:     "actor is an object",
+			setup:    func(g *GameV2) { g.Location = "west-of-house" },
+			command:  "mailbox, open",
+			contains: "can't talk to the",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewGameV2("test")
+			tt.setup(g)
+			result := g.Process(tt.command)
+			if !strings.Contains(result, tt.contains) {
+				t.Errorf("%q: expected %q, got: %s", tt.command, tt.contains, result)
+			}
+		})
+	}
+}
+
+func TestActorOdysseus(t *testing.T) {
+	g := NewGameV2("test")
+	g.Location = "cyclops-room"
+
+	result := g.Process("cyclops, odysseus")
+	if !strings.Contains(result, "flees the room") {
+		t.Errorf("Expected cyclops to flee, got: %s", result)
+	}
+	if !g.Flags["magic-flag"] {
+		t.Error("Expected magic-flag to be set after addressing the cyclops")
+	}
+}
@@ -12,6 +12,7 @@ type Command struct {
 	Preposition    string // Preposition (IN, ON, WITH, etc.)
 	IndirectObject string // PRSI in ZIL (indirect object)
 	Direction      string // Special case for movement (P-WALK-DIR in ZIL)
+	Actor          string // NPC being addressed, e.g. "troll, ..." (WINNER in ZIL)
 	Raw            string // Original input
 }
 
@@ -37,6 +38,12 @@ func (p *Parser) Parse(input string) (*Command, error) {
 		return nil, fmt.Errorf("please enter a command")
 	}
 
+	// Commands addressed to another character: "troll, give me the axe"
+	// (ZIL PARSER switches WINNER when the sentence starts with an actor)
+	if cmd, ok, err := p.parseActorCommand(input); ok {
+		return cmd, err
+	}
+
 	// Store raw input
 	cmd := &Command{Raw: input}
 
@@ -110,6 +117,18 @@ func (p *Parser) Parse(input string) (*Command, error) {
 		pos++
 	}
 
+	// "give troll the axe" / "give me the axe" - recipient comes first
+	// (GIVE OBJECT OBJECT = V-SGIVE in ZIL)
+	if verb == "give" && pos == len(tokens) {
+		if recipient, gift := p.splitGiveObjects(objTokens); recipient != "" {
+			cmd.DirectObject = gift
+			cmd.Preposition = "to"
+			cmd.IndirectObject = recipient
+			p.lastObject = gift
+			return cmd, nil
+		}
+	}
+
 	// Resolve direct object
 	if len(objTokens) > 0 {
 		// Special case for save/restore commands - allow arbitrary filenames
@@ -154,6 +173,54 @@ func (p *Parser) Parse(input string) (*Command, error) {
 	return cmd, nil
 }
 
+// parseActorCommand handles "<actor>, <command>" input. The second result
+// reports whether the input was addressed to an actor at all.
+func (p *Parser) parseActorCommand(input string) (*Command, bool, error) {
+	comma := strings.Index(input, ",")
+	if comma <= 0 {
+		return nil, false, nil
+	}
+
+	actorTokens := p.resolveIt(p.tokenize(input[:comma]))
+	if len(actorTokens) == 0 || p.vocabulary.GetVerb(actorTokens[0]) != "" {
+		return nil, false, nil
+	}
+	actor := p.resolveObject(actorTokens)
+	if actor == "" {
+		return nil, false, nil
+	}
+
+	rest := strings.TrimSpace(input[comma+1:])
+	if rest == "" {
+		return nil, true, fmt.Errorf("What do you want the %s to do?", actor)
+	}
+
+	cmd, err := p.Parse(rest)
+	if err != nil {
+		return nil, true, err
+	}
+	cmd.Actor = actor
+	cmd.Raw = input
+	return cmd, true, nil
+}
+
+// splitGiveObjects splits "troll axe" into recipient and gift. It returns
+// empty strings when the tokens name a single object.
+func (p *Parser) splitGiveObjects(tokens []string) (string, string) {
+	if len(tokens) < 2 || p.vocabulary.GetObject(strings.Join(tokens, " ")) != "" {
+		return "", ""
+	}
+	recipient := p.vocabulary.GetObject(tokens[0])
+	if recipient == "" {
+		return "", ""
+	}
+	gift := p.resolveObject(tokens[1:])
+	if gift == "" {
+		return "", ""
+	}
+	return recipient, gift
+}
+
 // tokenize breaks input into words (equivalent to ZIL's LEXV processing)
 func (p *Parser) tokenize(input string) []string {
 	// Convert to lowercase and split on whitespace
@@ -163,6 +230,12 @@ func (p *Parser) tokenize(input string) []string {
 	// Remove punctuation and articles
 	filtered := []string{}
 	for _, word := range words {
+		// A lone "?" is a synonym for HELP, not punctuation
+		if word == "?" {
+			filtered = append(filtered, word)
+			continue
+		}
+
 		// Remove trailing punctuation
 		word = strings.TrimRight(word, ".,!?;:")
 
@@ -0,0 +1,67 @@
+package engine
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// fuzzSeeds are starting inputs for the fuzz targets, covering each parser
+// path: directions, multi-word verbs, prepositions, actors and junk.
+var fuzzSeeds = []string{
+	"north",
+	"go south",
+	"take lamp",
+	"look at mailbox",
+	"put sword in trophy case",
+	"give me the axe",
+	"troll, give me the axe",
+	"cyclops, odysseus",
+	"turn lamp on",
+	"examine it",
+	"save my_game",
+	",",
+	"?",
+	"the the the",
+	"put in in in",
+	"troll,,,, ,",
+	"\x00\xff",
+}
+
+// FuzzParse checks that the parser never panics and always returns either a
+// command or an error.
+func FuzzParse(f *testing.F) {
+	for _, seed := range fuzzSeeds {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, input string) {
+		cmd, err := NewParser().Parse(input)
+		if err == nil && cmd == nil {
+			t.Fatalf("Parse(%q) returned neither command nor error", input)
+		}
+		if err == nil && cmd.Verb == "" {
+			t.Fatalf("Parse(%q) returned a command without a verb", input)
+		}
+	})
+}
+
+// FuzzProcess checks that no input, typed at any point in a short game,
+// panics the engine.
+func FuzzProcess(f *testing.F) {
+	for _, seed := range fuzzSeeds {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, input string) {
+		g := NewGameV2("test")
+		g.rand = rand.New(rand.NewSource(1))
+		for _, room := range []string{"west-of-house", "troll-room", "cyclops-room", "living-room"} {
+			g.Location = room
+			cmd, err := g.Parser.Parse(input)
+			if err == nil && (cmd.Verb == "save" || cmd.Verb == "restore") {
+				return // touches the filesystem
+			}
+			g.Process(input)
+		}
+	})
+}
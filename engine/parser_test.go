@@ -213,3 +213,47 @@ func TestIT(t *testing.T) {
 		t.Errorf("IT reference failed: got %q, want lamp", cmd2.DirectObject)
 	}
 }
+
+// TestActorCommands tests commands addressed to an NPC ("troll, ...")
+func TestActorCommands(t *testing.T) {
+	tests := []struct {
+		input    string
+		actor    string
+		verb     string
+		obj      string
+		indirect string
+	}{
+		{"troll, give me the axe", "troll", "give", "axe", "me"},
+		{"cyclops, odysseus", "cyclops", "ulysses", "", ""},
+		{"thief, go north", "thief", "walk", "", ""},
+		{"give troll the sword", "", "give", "sword", "troll"},
+		{"give coins to troll", "", "give", "coins", "troll"},
+	}
+
+	p := NewParser()
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			cmd, err := p.Parse(tt.input)
+			if err != nil {
+				t.Fatalf("Parse(%q) error = %v", tt.input, err)
+			}
+			if cmd.Actor != tt.actor {
+				t.Errorf("Actor = %q, want %q", cmd.Actor, tt.actor)
+			}
+			if cmd.Verb != tt.verb {
+				t.Errorf("Verb = %q, want %q", cmd.Verb, tt.verb)
+			}
+			if cmd.DirectObject != tt.obj {
+				t.Errorf("DirectObject = %q, want %q", cmd.DirectObject, tt.obj)
+			}
+			if cmd.IndirectObject != tt.indirect {
+				t.Errorf("IndirectObject = %q, want %q", cmd.IndirectObject, tt.indirect)
+			}
+		})
+	}
+
+	if _, err := p.Parse("troll,"); err == nil {
+		t.Error("Parse(\"troll,\") expected error, got nil")
+	}
+}
@@ -0,0 +1,237 @@
+package engine
+
+// The Frigid River and the magic boat (RBOAT-FUNCTION, I-RIVER, RIVER-NEXT,
+// RIVER-SPEEDS and RIVER-LAUNCH in ZIL)
+//
+// Water rooms can only be reached in the boat. Once launched, the current
+// carries the boat one stretch downstream every few turns, faster the nearer
+// it gets to Aragain Falls; anyone still on the river at the last stretch
+// goes over the falls. Sharp objects and thin plastic do not mix.
+
+// riverNext is the next stretch downstream (RIVER-NEXT in ZIL)
+var riverNext = map[string]string{
+	"river-1": "river-2",
+	"river-2": "river-3",
+	"river-3": "river-4",
+	"river-4": "river-5",
+}
+
+// riverSpeeds is how many turns the current takes to carry the boat on from
+// each stretch (RIVER-SPEEDS in ZIL)
+var riverSpeeds = map[string]int{
+	"river-1": 4,
+	"river-2": 4,
+	"river-3": 3,
+	"river-4": 2,
+	"river-5": 1,
+}
+
+// riverLaunch is where the boat ends up when launched from each shore
+// (RIVER-LAUNCH in ZIL)
+var riverLaunch = map[string]string{
+	"dam-base":           "river-1",
+	"white-cliffs-north": "river-3",
+	"white-cliffs-south": "river-4",
+	"shore":              "river-5",
+	"sandy-beach":        "river-4",
+	"reservoir-south":    "reservoir",
+	"reservoir-north":    "reservoir",
+	"stream-view":        "in-stream",
+}
+
+// riverBanks explains why the boat cannot land on some stretches (the NEXIT
+// messages of the river rooms in ZIL)
+var riverBanks = map[string]map[string]string{
+	"river-1": {"east": "The White Cliffs prevent your landing here."},
+	"river-2": {
+		"land": "There is no safe landing spot here.",
+		"east": "The White Cliffs prevent your landing here.",
+		"west": "Just in time you steer away from the rocks.",
+	},
+	"river-3": {"east": "The White Cliffs prevent your landing here."},
+	"river-4": {"land": "You can land either to the east or the west."},
+	"river-5": {"west": "Just in time you steer away from the rocks."},
+}
+
+// sharpObjects puncture the boat (RBOAT-FUNCTION in ZIL)
+var sharpObjects = []string{"sceptre", "knife", "sword", "rusty-knife", "axe", "stiletto"}
+
+// Boat states. There is one boat item, and it changes in place as it is
+// inflated, deflated, punctured and plugged (INFLATABLE-BOAT, INFLATED-BOAT
+// and PUNCTURED-BOAT in ZIL).
+const (
+	boatDeflated  = "deflated"
+	boatInflated  = "inflated"
+	boatPunctured = "punctured"
+)
+
+// setBoatState turns the boat into its deflated, inflated or punctured form.
+// Only the inflated boat is a vehicle and can hold things.
+func (g *GameV2) setBoatState(state string) {
+	boat := g.Items["boat"]
+	g.BoatState = state
+	boat.Flags.IsContainer = state == boatInflated
+	boat.Flags.IsOpen = state == boatInflated
+	boat.Vehicle = nil
+
+	switch state {
+	case boatInflated:
+		boat.Name = "magic boat"
+		boat.Description = "There is an inflated boat here."
+		boat.Vehicle = &Vehicle{Boardable: true, Floats: true} // VEHBIT
+	case boatPunctured:
+		boat.Name = "punctured boat"
+		boat.Description = "There is a punctured boat here."
+	default:
+		boat.Name = "inflatable boat"
+		boat.Description = "There is an inflatable boat here."
+	}
+}
+
+// isBoat reports whether an item is the inflated boat, whose own rules apply
+// while the player sits in it (RBOAT-FUNCTION in ZIL)
+func isBoat(item *Item) bool {
+	return item != nil && item.ID == "boat" && item.Vehicle != nil
+}
+
+// onWater reports whether a room can only be reached by boat (NONLANDBIT in
+// ZIL). The drained reservoir can be walked across.
+func (g *GameV2) onWater(roomID string) bool {
+	room := g.Rooms[roomID]
+	if room == nil || !room.Flags.IsWater {
+		return false
+	}
+	return !(roomID == "reservoir" && g.Flags["low-tide"])
+}
+
+// boatFunction handles the player's commands while they sit in the boat
+// (RBOAT-FUNCTION M-BEG in ZIL). It returns false for commands that work as
+// usual.
+func (g *GameV2) boatFunction(cmd *Command) (string, bool) {
+	boat := g.Items[g.Player.Vehicle]
+	if boat == nil {
+		g.Player.Vehicle = ""
+		return "", false
+	}
+
+	switch cmd.Verb {
+	case "walk":
+		switch {
+		case cmd.Direction == "land" || cmd.Direction == "east" || cmd.Direction == "west":
+		case g.Location == "reservoir" && (cmd.Direction == "north" || cmd.Direction == "south"):
+		case g.Location == "in-stream" && cmd.Direction == "south":
+		default:
+			return "Read the label for the boat's instructions.", true
+		}
+		if room := g.Rooms[g.Location]; room != nil && room.Exits[cmd.Direction] == nil {
+			if msg := riverBanks[g.Location][cmd.Direction]; msg != "" {
+				return msg, true
+			}
+		}
+		return "", false
+	case "launch":
+		return g.launchBoat(), true
+	case "take":
+		if item := g.findItem(cmd.DirectObject); item == boat {
+			return "You're inside of the " + boat.Name + ".", true
+		}
+	case "drop":
+		if item := g.findItemInInventory(cmd.DirectObject); item != nil && item.Flags.IsWeapon {
+			return g.punctureBoat(), true
+		}
+	case "put":
+		item := g.findItemInInventory(cmd.DirectObject)
+		if item != nil && item.Flags.IsWeapon && g.findItem(cmd.IndirectObject) == boat {
+			return g.punctureBoat(), true
+		}
+	case "attack", "kill", "break":
+		weapon := g.findItemInInventory(cmd.IndirectObject)
+		if weapon != nil && containsString(sharpObjects, weapon.ID) && g.findItem(cmd.DirectObject) == boat {
+			return g.punctureBoat(), true
+		}
+	}
+	return "", false
+}
+
+// launchBoat pushes off from the shore (RBOAT-FUNCTION and GO-NEXT in ZIL)
+func (g *GameV2) launchBoat() string {
+	if g.onWater(g.Location) {
+		switch g.Location {
+		case "reservoir":
+			return "You are on the reservoir, or have you forgotten?"
+		case "in-stream":
+			return "You are on the stream, or have you forgotten?"
+		}
+		return "You are on the river, or have you forgotten?"
+	}
+
+	to, ok := riverLaunch[g.Location]
+	if !ok {
+		return "You can't launch it here."
+	}
+	g.moveVehicle(g.Player.Vehicle, to)
+	g.RiverTurns = riverSpeeds[to]
+	return g.goTo(to)
+}
+
+// handleLaunch handles LAUNCH outside the boat (V-LAUNCH in ZIL)
+func (g *GameV2) handleLaunch(objName string) string {
+	if objName == "" {
+		return "Launch what?"
+	}
+	item := g.findItem(objName)
+	if item == nil {
+		return "You can't see any " + objName + " here."
+	}
+	if isBoat(item) {
+		return "You're not in the boat!"
+	}
+	return "That's pretty weird."
+}
+
+// processRiver lets the current carry the boat downstream (I-RIVER in ZIL)
+func (g *GameV2) processRiver() string {
+	if g.RiverTurns == 0 || g.Dead || g.GameOver {
+		return ""
+	}
+	if _, onRiver := riverSpeeds[g.Location]; !onRiver || g.Player.Vehicle == "" {
+		g.RiverTurns = 0
+		return ""
+	}
+
+	g.RiverTurns--
+	if g.RiverTurns > 0 {
+		return ""
+	}
+
+	next, ok := riverNext[g.Location]
+	if !ok {
+		return g.jigsUp("Unfortunately, the magic boat doesn't provide protection from the rocks and boulders one meets at the bottom of waterfalls. Including this one.")
+	}
+	g.moveVehicle(g.Player.Vehicle, next)
+	g.RiverTurns = riverSpeeds[next]
+	return "The flow of the river carries you downstream.\n\n" + g.goTo(next)
+}
+
+// punctureBoat deflates the boat for good, spilling its contents and its
+// passenger (RBOAT-FUNCTION in ZIL)
+func (g *GameV2) punctureBoat() string {
+	where := g.parentOf("boat")
+	for _, id := range append([]string{}, g.contentsOf("boat")...) {
+		g.moveItem(id, where)
+	}
+	g.setBoatState(boatPunctured)
+	g.Player.Vehicle = ""
+	return "Oops! Something sharp seems to have slipped and punctured the boat. The boat deflates to the sounds of hissing, sputtering, and cursing."
+}
+
+// carryingSharpObject reports whether the player holds anything that would
+// puncture the boat
+func (g *GameV2) carryingSharpObject() bool {
+	for _, id := range sharpObjects {
+		if g.parentOf(id) == "inventory" {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,134 @@
+package engine
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+// boatAtDamBase puts the player at the dam base sitting in the inflated boat
+func boatAtDamBase(t *testing.T) *GameV2 {
+	t.Helper()
+	g := NewGameV2("test")
+	g.rand = rand.New(rand.NewSource(1))
+	g.Location = "dam-base"
+	g.moveItem("pump", "inventory")
+
+	if result := g.Process("inflate boat with pump"); !strings.Contains(result, "inflates") {
+		t.Fatalf("inflate boat: %s", result)
+	}
+	if result := g.Process("board boat"); !strings.Contains(result, "You are now in the magic boat") {
+		t.Fatalf("board boat: %s", result)
+	}
+	return g
+}
+
+func TestRiverCurrentCarriesBoatOverFalls(t *testing.T) {
+	g := boatAtDamBase(t)
+
+	g.Process("launch")
+	if g.Location != "river-1" {
+		t.Fatalf("Location after launch = %q, want river-1", g.Location)
+	}
+
+	// RIVER-SPEEDS: 4 turns on river-1 (counting the launch) and river-2,
+	// 3 on river-3, 2 on river-4, then 1 on river-5 before the falls
+	want := []string{"river-1", "river-1", "river-2"}
+	for i, room := range want {
+		result := g.Process("wait")
+		if g.Location != room {
+			t.Fatalf("wait %d: Location = %q, want %q", i+1, g.Location, room)
+		}
+		if room == "river-2" && !strings.Contains(result, "carries you downstream") {
+			t.Errorf("Expected the current to be mentioned, got: %s", result)
+		}
+	}
+	if g.Items["boat"].Location != "river-2" {
+		t.Errorf("boat Location = %q, want it carried along to river-2", g.Items["boat"].Location)
+	}
+
+	var result string
+	for i := 0; i < 12 && g.Deaths == 0; i++ {
+		result = g.Process("wait")
+	}
+	if g.Deaths != 1 || !strings.Contains(result, "bottom of waterfalls") {
+		t.Errorf("Expected to go over Aragain Falls, got: %s", result)
+	}
+	if g.Player.Vehicle != "" {
+		t.Errorf("Vehicle = %q after dying, want the player out of the boat", g.Player.Vehicle)
+	}
+}
+
+func TestInterfaceCommandsTakeNoTime(t *testing.T) {
+	g := boatAtDamBase(t)
+	g.Process("launch")
+	moves := g.Moves
+
+	for i := 0; i < 10; i++ {
+		g.Process("score")
+		g.Process("version")
+		g.Process("brief")
+	}
+	if g.Location != "river-1" || g.Moves != moves {
+		t.Errorf("Location = %q, Moves = %d; want still on river-1 after %d moves", g.Location, g.Moves, moves)
+	}
+}
+
+func TestLandingAndDisembarking(t *testing.T) {
+	g := boatAtDamBase(t)
+	g.Process("launch")
+
+	if result := g.Process("north"); !strings.Contains(result, "Read the label") {
+		t.Errorf("Expected the boat to refuse north, got: %s", result)
+	}
+	if result := g.Process("disembark"); !strings.Contains(result, "fatal") {
+		t.Errorf("Expected getting out on the river to be refused, got: %s", result)
+	}
+
+	result := g.Process("land")
+	if g.Location != "dam-base" || !strings.Contains(result, "comes to a rest on the shore") {
+		t.Fatalf("land: Location = %q, result: %s", g.Location, result)
+	}
+	if g.Items["boat"].Location != "dam-base" {
+		t.Errorf("boat Location = %q, want dam-base", g.Items["boat"].Location)
+	}
+	if result := g.Process("north"); !strings.Contains(result, "Read the label") {
+		t.Errorf("Expected the boat to stay put on land, got: %s", result)
+	}
+	if result := g.Process("disembark"); !strings.Contains(result, "on your own feet") {
+		t.Errorf("disembark: %s", result)
+	}
+	if g.Process("north"); g.Location != "dam-room" {
+		t.Errorf("Location = %q, want dam-room on foot", g.Location)
+	}
+}
+
+func TestWaterNeedsBoat(t *testing.T) {
+	g := NewGameV2("test")
+	g.Location = "river-1"
+
+	if result := g.Process("down"); !strings.Contains(result, "without a vehicle") || g.Location != "river-1" {
+		t.Errorf("Expected to need a boat, got %q in %s", result, g.Location)
+	}
+	if result := g.Process("launch boat"); !strings.Contains(result, "can't see") {
+		t.Errorf("launch boat with no boat: %s", result)
+	}
+}
+
+func TestSharpObjectsPunctureBoat(t *testing.T) {
+	g := boatAtDamBase(t)
+	g.Process("disembark")
+	g.moveItem("sword", "inventory")
+
+	result := g.Process("board boat")
+	if !strings.Contains(result, "punctured the boat") {
+		t.Fatalf("Expected the sword to puncture the boat, got: %s", result)
+	}
+	if g.Player.Vehicle != "" || g.Items["boat"].Location != "dam-base" || g.BoatState != boatPunctured {
+		t.Errorf("Vehicle = %q, boat %s in %q, want it punctured at dam-base",
+			g.Player.Vehicle, g.BoatState, g.Items["boat"].Location)
+	}
+	if g.Items["boat-label"].Location != "dam-base" {
+		t.Errorf("boat-label Location = %q, want it left on the shore", g.Items["boat-label"].Location)
+	}
+}
@@ -35,6 +35,7 @@ func createAboveGroundRooms(g *GameV2) {
 	// Note: EAST blocked by boarded door
 	westOfHouse.AddConditionalExit("sw", "stone-barrow", "won-flag", "")
 	westOfHouse.AddConditionalExit("in", "stone-barrow", "won-flag", "")
+	westOfHouse.Action = westOfHouseAction
 	g.Rooms["west-of-house"] = westOfHouse
 
 	// STONE-BARROW - Victory area
@@ -218,6 +219,7 @@ func createHouseRooms(g *GameV2) {
 	kitchen.AddConditionalExit("out", "behind-house", "window-open", "The window is closed.")
 	kitchen.AddExit("west", "living-room")
 	kitchen.AddExit("up", "attic")
+	kitchen.Flags.IsSacred = true // SACREDBIT in ZIL
 	g.Rooms["kitchen"] = kitchen
 
 	// ATTIC
@@ -227,6 +229,7 @@ func createHouseRooms(g *GameV2) {
 		"This is the attic. The only exit is a stairway leading down.",
 	)
 	attic.AddExit("down", "kitchen")
+	attic.Flags.IsSacred = true // SACREDBIT in ZIL
 	g.Rooms["attic"] = attic
 
 	// LIVING-ROOM
@@ -238,6 +241,7 @@ func createHouseRooms(g *GameV2) {
 	livingRoom.AddExit("east", "kitchen")
 	livingRoom.AddConditionalExit("west", "strange-passage", "magic-flag", "The door is nailed shut.")
 	livingRoom.AddConditionalExit("down", "cellar", "trap-door-open", "You can't go that way.")
+	livingRoom.Flags.IsSacred = true // SACREDBIT in ZIL
 	g.Rooms["living-room"] = livingRoom
 }
 
@@ -536,28 +540,32 @@ func createReservoirArea(g *GameV2) {
 	reservoirSouth.AddExit("east", "dam-room")
 	reservoirSouth.AddExit("west", "stream-view")
 	reservoirSouth.AddConditionalExit("north", "reservoir", "low-tide", "You would drown.")
+	reservoirSouth.Action = reservoirSouthAction
 	g.Rooms["reservoir-south"] = reservoirSouth
 
 	// RESERVOIR
 	reservoir := NewRoom(
 		"reservoir",
 		"Reservoir",
-		"You are on the reservoir. The water is cold and provides little buoyancy.",
+		"You are on the lake. Beaches can be seen north and south. Upstream a small stream enters the lake through a narrow cleft in the rocks. The dam can be seen downstream.",
 	)
+	reservoir.Flags.IsWater = true
 	reservoir.AddExit("north", "reservoir-north")
-	reservoir.AddConditionalExit("south", "reservoir-south", "low-tide", "You would drown.")
+	reservoir.AddExit("south", "reservoir-south")
 	reservoir.AddExit("up", "in-stream")
 	reservoir.AddExit("west", "in-stream")
+	reservoir.Action = reservoirAction
 	g.Rooms["reservoir"] = reservoir
 
 	// RESERVOIR-NORTH
 	reservoirNorth := NewRoom(
 		"reservoir-north",
 		"Reservoir North",
-		"You are in a long room on the north shore of a large lake, far too deep and wide for crossing.",
+		"You are in a large cavernous room, north of a large lake.",
 	)
 	reservoirNorth.AddExit("north", "atlantis-room")
 	reservoirNorth.AddConditionalExit("south", "reservoir", "low-tide", "You would drown.")
+	reservoirNorth.Action = reservoirNorthAction
 	g.Rooms["reservoir-north"] = reservoirNorth
 
 	// STREAM-VIEW
@@ -575,6 +583,7 @@ func createReservoirArea(g *GameV2) {
 		"Stream",
 		"You are on the gently flowing stream. The upstream route is too narrow to navigate, and the downstream route is invisible due to twisting walls. There is a narrow beach to land on.",
 	)
+	inStream.Flags.IsWater = true
 	inStream.AddExit("land", "stream-view")
 	inStream.AddExit("down", "reservoir")
 	inStream.AddExit("east", "reservoir")
@@ -589,6 +598,7 @@ func createMirrorRooms(g *GameV2) {
 		"Mirror Room",
 		"You are in a large square room with tall ceilings. On the south wall is an enormous mirror which fills the entire wall. There are exits on the other three sides of the room.",
 	)
+	mirrorRoom1.Action = mirrorRoomAction
 	mirrorRoom1.AddExit("north", "cold-passage") // per ZIL
 	mirrorRoom1.AddExit("west", "twisting-passage") // per ZIL
 	mirrorRoom1.AddExit("east", "small-cave") // per ZIL
@@ -600,6 +610,7 @@ func createMirrorRooms(g *GameV2) {
 		"Mirror Room",
 		"You are in a large square room with tall ceilings. On the north wall is an enormous mirror which fills the entire wall. There are exits on the other three sides of the room.",
 	)
+	mirrorRoom2.Action = mirrorRoomAction
 	mirrorRoom2.AddExit("west", "winding-passage") // per ZIL
 	mirrorRoom2.AddExit("north", "narrow-passage") // per ZIL
 	mirrorRoom2.AddExit("east", "tiny-cave") // per ZIL
@@ -715,12 +726,13 @@ func createRoundRoomArea(g *GameV2) {
 	deepCanyon := NewRoom(
 		"deep-canyon",
 		"Deep Canyon",
-		"You are on the south edge of a deep canyon. Passages lead off to the east, northwest, and southwest.",
+		"You are on the south edge of a deep canyon. Passages lead off to the east, northwest and southwest. A stairway leads down.",
 	)
 	deepCanyon.AddExit("nw", "reservoir-south")
 	deepCanyon.AddExit("east", "dam-room") // matches dam-room west
 	deepCanyon.AddExit("sw", "ns-passage")
 	deepCanyon.AddExit("down", "loud-room")
+	deepCanyon.Action = deepCanyonAction
 	g.Rooms["deep-canyon"] = deepCanyon
 
 	// DAMP-CAVE
@@ -739,8 +751,9 @@ func createRoundRoomArea(g *GameV2) {
 	loudRoom := NewRoom(
 		"loud-room",
 		"Loud Room",
-		"This is a large room with a ceiling which cannot be detected from the ground. There is a narrow passage from east to west and a stone stairway leading upward. The room is extremely noisy. In fact, it is difficult to hear yourself think.",
+		"This is a large room with a ceiling which cannot be detected from the ground. There is a narrow passage from east to west and a stone stairway leading upward.",
 	)
+	loudRoom.Action = loudRoomAction
 	loudRoom.Flags.IsLit = false
 	loudRoom.Flags.IsDark = true
 	loudRoom.AddExit("east", "damp-cave")
@@ -857,6 +870,7 @@ func createTempleArea(g *GameV2) {
 	northTemple.AddExit("out", "torch-room")
 	northTemple.AddExit("up", "torch-room")
 	northTemple.AddExit("south", "south-temple")
+	northTemple.Flags.IsSacred = true // SACREDBIT in ZIL
 	g.Rooms["north-temple"] = northTemple
 
 	// SOUTH-TEMPLE
@@ -867,6 +881,7 @@ func createTempleArea(g *GameV2) {
 	)
 	southTemple.AddExit("north", "north-temple")
 	southTemple.AddConditionalExit("down", "tiny-cave", "coffin-cure", "You haven't a prayer of getting the coffin down there.")
+	southTemple.Flags.IsSacred = true // SACREDBIT in ZIL
 	g.Rooms["south-temple"] = southTemple
 }
 
@@ -883,6 +898,7 @@ func createDamArea(g *GameV2) {
 	damRoom.AddExit("east", "dam-base") // per ZIL
 	damRoom.AddExit("north", "dam-lobby") // per ZIL
 	damRoom.AddExit("west", "reservoir-south") // per ZIL
+	damRoom.Action = damRoomAction
 	g.Rooms["dam-room"] = damRoom
 
 	// DAM-LOBBY
@@ -930,6 +946,7 @@ func createRiverArea(g *GameV2) {
 		"You are on the Frigid River in the vicinity of the Dam. The river flows quietly here. There is a landing on the west shore.",
 	)
 	river1.Flags.IsOutdoors = true
+	river1.Flags.IsWater = true
 	river1.AddExit("west", "dam-base")
 	river1.AddExit("land", "dam-base")
 	river1.AddExit("down", "river-2")
@@ -942,6 +959,7 @@ func createRiverArea(g *GameV2) {
 		"The river turns a corner here making it impossible to see the Dam. The White Cliffs loom on the east bank and large rocks prevent landing on the west.",
 	)
 	river2.Flags.IsOutdoors = true
+	river2.Flags.IsWater = true
 	river2.AddExit("down", "river-3")
 	g.Rooms["river-2"] = river2
 
@@ -952,6 +970,7 @@ func createRiverArea(g *GameV2) {
 		"The river descends here into a valley. There is a narrow beach on the west shore below the cliffs. In the distance a faint rumbling can be heard.",
 	)
 	river3.Flags.IsOutdoors = true
+	river3.Flags.IsWater = true
 	river3.AddExit("down", "river-4")
 	river3.AddExit("land", "white-cliffs-north")
 	river3.AddExit("west", "white-cliffs-north")
@@ -985,6 +1004,7 @@ func createRiverArea(g *GameV2) {
 		"The river is running faster here and the sound ahead appears to be that of rushing water. On the east shore is a sandy beach. A small area of beach can also be seen below the cliffs on the west shore.",
 	)
 	river4.Flags.IsOutdoors = true
+	river4.Flags.IsWater = true
 	river4.AddExit("down", "river-5")
 	river4.AddExit("west", "white-cliffs-south")
 	river4.AddExit("east", "sandy-beach")
@@ -997,6 +1017,7 @@ func createRiverArea(g *GameV2) {
 		"The sound of rushing water is nearly unbearable here. On the east shore is a large landing area.",
 	)
 	river5.Flags.IsOutdoors = true
+	river5.Flags.IsWater = true
 	river5.AddExit("east", "shore")
 	river5.AddExit("land", "shore")
 	g.Rooms["river-5"] = river5
@@ -1010,7 +1031,6 @@ func createRiverArea(g *GameV2) {
 	shore.Flags.IsOutdoors = true
 	shore.AddExit("north", "sandy-beach")
 	shore.AddExit("south", "aragain-falls")
-	shore.AddExit("west", "river-5") // ADD: reverse of river-5 east/land
 	g.Rooms["shore"] = shore
 
 	// SANDY-BEACH
@@ -1022,7 +1042,6 @@ func createRiverArea(g *GameV2) {
 	sandyBeach.Flags.IsOutdoors = true
 	sandyBeach.AddExit("ne", "sandy-cave")
 	sandyBeach.AddExit("south", "shore")
-	sandyBeach.AddExit("west", "river-4") // ADD: reverse of river-4 east
 	g.Rooms["sandy-beach"] = sandyBeach
 
 	// SANDY-CAVE
@@ -1155,6 +1174,8 @@ func createCoalMineArea(g *GameV2) {
 		"This is a small room which smells strongly of coal gas. There is a short climb up some stairs and a narrow tunnel leading east.",
 	)
 	gasRoom.Flags.IsOutdoors = false
+	gasRoom.Flags.IsSacred = true
+	gasRoom.Action = gasRoomAction
 	gasRoom.AddExit("up", "smelly-room")
 	gasRoom.AddExit("east", "mine-1")
 	gasRoom.AddExit("south", "mine-1") // ADD: reverse of mine-1 north
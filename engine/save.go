@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 	"time"
 )
 
@@ -23,6 +24,15 @@ type GameState struct {
 	Flags         map[string]bool   `json:"flags"`
 	GameOver      bool              `json:"game_over"`
 	Won           bool              `json:"won"`
+	Dead          bool              `json:"dead,omitempty"`
+	Deaths        int               `json:"deaths,omitempty"`
+	Visited       []string          `json:"visited,omitempty"`
+	Darkness      DarknessTracker   `json:"darkness"`
+	RiverTurns    int               `json:"river_turns,omitempty"`
+	MatchCount    int               `json:"match_count"`
+	BoatState     string            `json:"boat_state,omitempty"`
+	ReservoirTurns int              `json:"reservoir_turns,omitempty"`
+	WaterLevel    int               `json:"water_level,omitempty"`
 	PlayerState   PlayerState       `json:"player"`
 	ItemStates    map[string]ItemState `json:"items"`
 	NPCStates     map[string]NPCState  `json:"npcs"`
@@ -33,6 +43,11 @@ type PlayerState struct {
 	Inventory []string `json:"inventory"`
 	Health    int      `json:"health"`
 	MaxWeight int      `json:"max_weight"`
+	Staggered bool     `json:"staggered,omitempty"`
+	StrengthModifier int `json:"strength_modifier,omitempty"`
+	CureTurns        int `json:"cure_turns,omitempty"`
+	Weapon           string `json:"weapon,omitempty"`
+	Vehicle          string `json:"vehicle,omitempty"`
 }
 
 // ItemState holds serializable item data
@@ -51,6 +66,7 @@ type NPCState struct {
 	Weapon    string   `json:"weapon,omitempty"`
 	Inventory []string `json:"inventory,omitempty"`
 	Hostile   bool     `json:"hostile"`
+	WakeChance int     `json:"wake_chance,omitempty"`
 }
 
 // getSaveDir returns the platform-specific save directory
@@ -120,10 +136,23 @@ func (g *GameV2) serializeState() GameState {
 		Flags:    make(map[string]bool),
 		GameOver: g.GameOver,
 		Won:      g.Won,
+		Dead:     g.Dead,
+		Deaths:   g.Deaths,
+		Darkness: g.Darkness,
+		RiverTurns: g.RiverTurns,
+		MatchCount: g.MatchCount,
+		BoatState: g.BoatState,
+		ReservoirTurns: g.ReservoirTurns,
+		WaterLevel: g.WaterLevel,
 		PlayerState: PlayerState{
 			Inventory: g.Player.Inventory,
 			Health:    g.Player.Health,
 			MaxWeight: g.Player.MaxWeight,
+			Staggered: g.Player.Staggered,
+			StrengthModifier: g.Player.StrengthModifier,
+			CureTurns:        g.Player.CureTurns,
+			Weapon:           g.Player.Weapon,
+			Vehicle:          g.Player.Vehicle,
 		},
 		ItemStates: make(map[string]ItemState),
 		NPCStates:  make(map[string]NPCState),
@@ -134,6 +163,14 @@ func (g *GameV2) serializeState() GameState {
 		state.Flags[k] = v
 	}
 
+	// Rooms the player has been to (TOUCHBIT in ZIL)
+	for id, room := range g.Rooms {
+		if !room.FirstVisit {
+			state.Visited = append(state.Visited, id)
+		}
+	}
+	sort.Strings(state.Visited)
+
 	// Serialize items (only dynamic state, not static definitions)
 	for id, item := range g.Items {
 		state.ItemStates[id] = ItemState{
@@ -153,6 +190,7 @@ func (g *GameV2) serializeState() GameState {
 			Weapon:    npc.Weapon,
 			Inventory: npc.Inventory,
 			Hostile:   npc.Hostile,
+			WakeChance: npc.WakeChance,
 		}
 	}
 
@@ -180,8 +218,10 @@ func (g *GameV2) Restore(filename string) error {
 		return fmt.Errorf("failed to read save file: %w", err)
 	}
 
-	// Unmarshal JSON
+	// Unmarshal JSON. Saves made before the matchbook was counted have no
+	// match_count and start with a full book.
 	var save SaveGame
+	save.GameState.MatchCount = matchesInBook
 	if err := json.Unmarshal(data, &save); err != nil {
 		return fmt.Errorf("failed to parse save file: %w", err)
 	}
@@ -204,11 +244,23 @@ func (g *GameV2) deserializeState(state GameState) {
 	g.Moves = state.Moves
 	g.GameOver = state.GameOver
 	g.Won = state.Won
+	g.Dead = state.Dead
+	g.Deaths = state.Deaths
+	g.Darkness = state.Darkness
+	g.RiverTurns = state.RiverTurns
+	g.MatchCount = state.MatchCount
+	g.ReservoirTurns = state.ReservoirTurns
+	g.WaterLevel = state.WaterLevel
 
 	// Restore player state
 	g.Player.Inventory = state.PlayerState.Inventory
 	g.Player.Health = state.PlayerState.Health
 	g.Player.MaxWeight = state.PlayerState.MaxWeight
+	g.Player.Staggered = state.PlayerState.Staggered
+	g.Player.StrengthModifier = state.PlayerState.StrengthModifier
+	g.Player.CureTurns = state.PlayerState.CureTurns
+	g.Player.Weapon = state.PlayerState.Weapon
+	g.Player.Vehicle = state.PlayerState.Vehicle
 
 	// Restore flags
 	g.Flags = make(map[string]bool)
@@ -216,6 +268,16 @@ func (g *GameV2) deserializeState(state GameState) {
 		g.Flags[k] = v
 	}
 
+	// Restore visited rooms
+	for _, room := range g.Rooms {
+		room.FirstVisit = true
+	}
+	for _, id := range state.Visited {
+		if room := g.Rooms[id]; room != nil {
+			room.FirstVisit = false
+		}
+	}
+
 	// Restore item states
 	for id, itemState := range state.ItemStates {
 		if item, ok := g.Items[id]; ok {
@@ -226,6 +288,27 @@ func (g *GameV2) deserializeState(state GameState) {
 		}
 	}
 
+	// Reshape the boat. Older saves kept the inflated and punctured boats as
+	// items of their own, and whichever is in play gives the boat's state.
+	boatState := state.BoatState
+	if boatState == "" {
+		boatState = boatDeflated
+		for _, old := range []string{boatInflated, boatPunctured} {
+			if s, ok := state.ItemStates[old+"-boat"]; ok && s.Location != "" {
+				boatState = old
+				g.Items["boat"].Location = s.Location
+			}
+		}
+		if label := g.Items["boat-label"]; label.Location == "inflated-boat" {
+			label.Location = "boat"
+		}
+		if g.Player.Vehicle == "inflated-boat" {
+			g.Player.Vehicle = "boat"
+		}
+	}
+	g.setBoatState(boatState)
+	g.setBasketLowered(g.Flags["basket-lowered"])
+
 	// Restore NPC states
 	for id, npcState := range state.NPCStates {
 		if npc, ok := g.NPCs[id]; ok {
@@ -235,8 +318,12 @@ func (g *GameV2) deserializeState(state GameState) {
 			npc.Weapon = npcState.Weapon
 			npc.Inventory = npcState.Inventory
 			npc.Hostile = npcState.Hostile
+			npc.WakeChance = npcState.WakeChance
 		}
 	}
+
+	// Room, inventory and container lists follow the restored locations
+	g.rebuildContainment()
 }
 
 // ListSaves returns a list of available save files
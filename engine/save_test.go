@@ -1,6 +1,7 @@
 package engine
 
 import (
+	"encoding/json"
 	"os"
 	"path/filepath"
 	"strings"
@@ -26,6 +27,7 @@ func TestSaveAndRestore(t *testing.T) {
 		lamp.Flags.IsLit = true
 		lamp.Fuel = 200
 	}
+	g.Items["sword"].Location = "inventory"
 
 	// Modify NPC state
 	if thief, ok := g.NPCs["thief"]; ok {
@@ -300,6 +302,40 @@ func TestSaveFileFormat(t *testing.T) {
 	os.Remove(savePath)
 }
 
+func TestRestoreOldSaveFillsMatchbook(t *testing.T) {
+	g := NewGameV2("test")
+	g.MatchCount = 2
+	if err := g.Save("test_no_matches"); err != nil {
+		t.Fatalf("Failed to save: %v", err)
+	}
+	savePath, _ := GetSavePath("test_no_matches")
+	defer os.Remove(savePath)
+
+	// Strip match_count to look like a save from before the matchbook
+	data, err := os.ReadFile(savePath)
+	if err != nil {
+		t.Fatalf("Failed to read save file: %v", err)
+	}
+	var save map[string]any
+	if err := json.Unmarshal(data, &save); err != nil {
+		t.Fatalf("Failed to parse save file: %v", err)
+	}
+	delete(save["game_state"].(map[string]any), "match_count")
+	if data, err = json.Marshal(save); err != nil {
+		t.Fatalf("Failed to write save file: %v", err)
+	}
+	os.WriteFile(savePath, data, 0644)
+
+	restored := NewGameV2("test")
+	restored.MatchCount = 0
+	if err := restored.Restore("test_no_matches"); err != nil {
+		t.Fatalf("Failed to restore: %v", err)
+	}
+	if restored.MatchCount != matchesInBook {
+		t.Errorf("MatchCount = %d, want %d for a save without one", restored.MatchCount, matchesInBook)
+	}
+}
+
 func TestSaveFilenameExtension(t *testing.T) {
 	g := NewGameV2("test")
 
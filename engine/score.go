@@ -0,0 +1,130 @@
+package engine
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Scoring (SCORE-UPD, SCORE-OBJ and the VALUE and TVALUE properties in ZIL)
+//
+// Points come from three places: reaching certain rooms for the first time,
+// picking up each treasure for the first time, and putting each treasure in
+// the trophy case. Every award is paid once and remembered in a flag, so the
+// score survives a save and SCORE can say where it came from. Each death
+// costs ten points.
+
+// roomPoints is what each room is worth the first time the player enters it
+// (VALUE of rooms in ZIL)
+var roomPoints = map[string]int{
+	"kitchen":       10,
+	"cellar":        25,
+	"ew-passage":    5,
+	"treasure-room": 25,
+	"lower-shaft":   13,
+}
+
+// takePoints is what each treasure is worth the first time it is picked up
+// (VALUE of objects in ZIL). Putting it in the trophy case earns its Value
+// on top (TVALUE in ZIL).
+var takePoints = map[string]int{
+	"skull":           10,
+	"sceptre":         4,
+	"coffin":          10,
+	"trident":         4,
+	"chalice":         10,
+	"diamond":         10,
+	"jade":            5,
+	"emerald":         5,
+	"coins":           10,
+	"painting":        4,
+	"bracelet":        5,
+	"platinum-bar":    10,
+	"pot-of-gold":     10,
+	"ivory-torch":     14,
+	"scarab":          5,
+	"egg":             5,
+	"canary":          6,
+	"bauble":          1,
+	"trunk-of-jewels": 15,
+}
+
+// Score flags are named for the kind of award and what earned it
+const (
+	enteredPrefix = "entered-"
+	foundPrefix   = "found-"
+	scoredPrefix  = "scored-"
+)
+
+// award adds points to the score the first time flag is raised
+func (g *GameV2) award(flag string, points int) {
+	if points == 0 || g.Flags[flag] {
+		return
+	}
+	g.Flags[flag] = true
+	g.Score += points
+}
+
+// scoreRoom pays for reaching a room for the first time (GOTO in ZIL)
+func (g *GameV2) scoreRoom(roomID string) {
+	g.award(enteredPrefix+roomID, roomPoints[roomID])
+}
+
+// scoreTake pays for picking up a treasure for the first time (SCORE-OBJ in
+// ZIL)
+func (g *GameV2) scoreTake(item *Item) {
+	g.award(foundPrefix+item.ID, takePoints[item.ID])
+}
+
+// scoreCase pays for putting a treasure in the trophy case. It reports
+// whether this is the first time.
+func (g *GameV2) scoreCase(item *Item) bool {
+	if !item.Flags.IsTreasure || g.Flags[scoredPrefix+item.ID] {
+		return false
+	}
+	g.award(scoredPrefix+item.ID, item.Value)
+	return true
+}
+
+// scoreBreakdown explains where the points came from, one line per award
+func (g *GameV2) scoreBreakdown() string {
+	var lines []string
+	for flag, set := range g.Flags {
+		if !set {
+			continue
+		}
+		switch {
+		case strings.HasPrefix(flag, enteredPrefix):
+			id := strings.TrimPrefix(flag, enteredPrefix)
+			if room := g.Rooms[id]; room != nil {
+				lines = append(lines, fmt.Sprintf("%3d  Reaching the %s", roomPoints[id], room.Name))
+			}
+		case strings.HasPrefix(flag, foundPrefix):
+			id := strings.TrimPrefix(flag, foundPrefix)
+			if item := g.Items[id]; item != nil {
+				lines = append(lines, fmt.Sprintf("%3d  Finding the %s", takePoints[id], item.Name))
+			}
+		case strings.HasPrefix(flag, scoredPrefix):
+			id := strings.TrimPrefix(flag, scoredPrefix)
+			if item := g.Items[id]; item != nil {
+				lines = append(lines, fmt.Sprintf("%3d  Putting the %s in the trophy case", item.Value, item.Name))
+			}
+		}
+	}
+	sort.Strings(lines)
+	if g.Deaths > 0 {
+		lines = append(lines, fmt.Sprintf("%3d  Dying %s", -10*g.Deaths, plural(g.Deaths, "time")))
+	}
+	if len(lines) == 0 {
+		return ""
+	}
+	return "Points so far:\n" + strings.Join(lines, "\n")
+}
+
+// plural formats a count of things, such as "1 time" or "2 times"
+func plural(n int, thing string) string {
+	if n == 1 {
+		return "1 " + thing
+	}
+	return fmt.Sprintf("%d %ss", n, thing)
+}
@@ -0,0 +1,58 @@
+package engine
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestScoreTableAddsUpTo350(t *testing.T) {
+	g := NewGameV2("test")
+
+	total := 0
+	for _, points := range roomPoints {
+		total += points
+	}
+	for id, points := range takePoints {
+		item := g.Items[id]
+		if item == nil || !item.Flags.IsTreasure {
+			t.Errorf("%s should be a treasure", id)
+			continue
+		}
+		total += points + item.Value
+	}
+	if total != ScoreMax {
+		t.Errorf("Scoring table adds up to %d, want %d", total, ScoreMax)
+	}
+}
+
+func TestFirstEntryAndTakeScoreOnce(t *testing.T) {
+	g := NewGameV2("test")
+	g.Location = "living-room"
+	g.Flags["trap-door-open"] = true
+	g.Items["trap-door"].Flags.IsOpen = true
+	g.moveItem("lamp", "inventory")
+	g.Items["lamp"].Flags.IsLit = true
+
+	g.Process("down")
+	if g.Score != 25 {
+		t.Errorf("Score = %d after reaching the cellar, want 25", g.Score)
+	}
+	g.Process("up")
+	g.Process("down")
+	if g.Score != 25 {
+		t.Errorf("Score = %d after reaching the cellar again, want 25", g.Score)
+	}
+
+	g.moveItem("diamond", "cellar")
+	g.Process("take diamond")
+	g.Process("drop diamond")
+	g.Process("take diamond")
+	if g.Score != 35 {
+		t.Errorf("Score = %d after finding the diamond, want 35", g.Score)
+	}
+
+	result := g.Process("score")
+	if !strings.Contains(result, " 25  Reaching the Cellar") || !strings.Contains(result, " 10  Finding the huge diamond") {
+		t.Errorf("Expected the score to be explained, got: %s", result)
+	}
+}
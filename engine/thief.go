@@ -0,0 +1,349 @@
+package engine
+
+import (
+	"sort"
+	"strings"
+)
+
+// The thief (I-THIEF, THIEF-VS-ADVENTURER, ROB, STEAL-JUNK, DROP-JUNK and
+// DEPOSIT-BOOTY in ZIL)
+//
+// The thief wanders the dungeon unseen, robbing rooms the player has been to
+// and picking up the odd piece of junk. When he shares a room with the player
+// he may show himself, rob them, or slip away again. His loot goes back to
+// his lair in the treasure room, which he defends.
+
+// thiefLair is where the thief keeps his booty
+const thiefLair = "treasure-room"
+
+// processThiefTurn runs one turn of the thief daemon (I-THIEF in ZIL)
+func (g *GameV2) processThiefTurn() string {
+	thief := g.NPCs["thief"]
+	if thief == nil || !thief.Flags.IsAlive || thief.Flags.IsUnconscious {
+		return ""
+	}
+
+	var messages []string
+	visible := !thief.Flags.IsInvisible
+
+	switch {
+	case thief.Location == thiefLair && g.Location != thiefLair:
+		// Home alone: unpack the bag
+		if visible {
+			g.hackTreasures()
+			visible = false
+		}
+		g.depositBooty()
+
+	case thief.Location == g.Location:
+		msg, busy := g.thiefVsAdventurer(visible)
+		if busy {
+			return msg
+		}
+		visible = !thief.Flags.IsInvisible
+
+	default:
+		thief.Flags.IsInvisible = true
+		visible = false
+		if room := g.Rooms[thief.Location]; room != nil && !room.FirstVisit {
+			g.rob(thief.Location, 75)
+			if msg := g.stealJunk(thief.Location); msg != "" {
+				messages = append(messages, msg)
+			}
+		}
+	}
+
+	// Move on unless he is showing himself to the player
+	if !visible {
+		g.recoverStiletto()
+		g.moveThiefToNextRoom()
+		if thief.Location != thiefLair {
+			if msg := g.dropJunk(thief.Location); msg != "" {
+				messages = append(messages, msg)
+			}
+		}
+	}
+
+	return strings.Join(messages, "\n")
+}
+
+// thiefVsAdventurer decides what the thief does when he is in the player's
+// room (THIEF-VS-ADVENTURER in ZIL). busy is true when he has done something
+// that keeps him here this turn.
+func (g *GameV2) thiefVsAdventurer(visible bool) (msg string, busy bool) {
+	thief := g.NPCs["thief"]
+
+	// His lair is handled when the player walks in
+	if g.Location == thiefLair || g.Dead {
+		return "", false
+	}
+
+	switch {
+	case !visible && g.randomChance(30):
+		if !g.npcWieldsWeapon(thief) {
+			return "", false
+		}
+		thief.Flags.IsInvisible = false
+		return "Someone carrying a large bag is casually leaning against one of the walls here. He does not speak, but it is clear from his aspect that the bag will be taken only over his dead body.", true
+
+	case visible && thief.Flags.IsFighting && !g.thiefWinning():
+		g.thiefLeaves()
+		return "Your opponent, determining discretion to be the better part of valor, decides to terminate this little contretemps. With a rueful nod of his head, he steps backward into the gloom and disappears.", true
+
+	case visible && thief.Flags.IsFighting && g.randomChance(90):
+		return "", true
+
+	case visible && g.randomChance(30):
+		g.thiefLeaves()
+		return "The holder of the large bag just left, looking disgusted. Fortunately, he took nothing.", true
+
+	case g.randomChance(70):
+		return "", visible
+	}
+
+	// Rob the room, then the player
+	hadLight := g.hasLight()
+	robbed := ""
+	if g.rob(g.Location, 100) {
+		robbed = "room"
+	}
+	if g.rob("inventory", g.withLuck(75)) {
+		robbed = "player"
+	}
+	stoleLight := ""
+	if hadLight && !g.hasLight() {
+		stoleLight = "\nThe thief seems to have left you in the dark."
+	}
+
+	if !visible {
+		if robbed == "" {
+			return "A \"lean and hungry\" gentleman just wandered through, carrying a large bag. Finding nothing of value, he left disgruntled.", true
+		}
+		from := "the room"
+		if robbed == "player" {
+			from = "your possession"
+		}
+		return "A seedy-looking individual with a large bag just wandered through the room. On the way through, he quietly abstracted some valuables from " + from + ", mumbling something about \"Doing unto others before...\"" + stoleLight, true
+	}
+
+	g.thiefLeaves()
+	switch robbed {
+	case "player":
+		return "The thief just left, still carrying his large bag. You may not have noticed that he robbed you blind first." + stoleLight, true
+	case "room":
+		return "The thief just left, still carrying his large bag. You may not have noticed that he appropriated the valuables in the room." + stoleLight, true
+	}
+	return "The thief, finding nothing of value, left disgusted.", true
+}
+
+// thiefLeaves makes the thief vanish from view, ending any fight
+func (g *GameV2) thiefLeaves() {
+	thief := g.NPCs["thief"]
+	thief.Flags.IsInvisible = true
+	thief.Flags.IsFighting = false
+	g.recoverStiletto()
+}
+
+// thiefWinning decides whether the thief thinks he is winning a fight; the
+// weaker he is against the player, the sooner he runs (WINNING? in ZIL)
+func (g *GameV2) thiefWinning() bool {
+	vs := g.villainStrength(g.NPCs["thief"])
+	ps := vs - g.fightStrength()
+	switch {
+	case ps > 3:
+		return g.randomChance(90)
+	case ps > 0:
+		return g.randomChance(75)
+	case ps == 0:
+		return g.randomChance(50)
+	case vs > 1:
+		return g.randomChance(25)
+	}
+	return g.randomChance(10)
+}
+
+// thiefProvoked reports whether the thief will start a fight without being
+// attacked: when cornered in his lair, or when the player is wounded
+func (g *GameV2) thiefProvoked(npc *NPC) bool {
+	return npc.ID == "thief" && !npc.Flags.IsInvisible &&
+		(npc.Location == thiefLair || g.woundPoints() > 0)
+}
+
+// rob moves visible valuables from a room or the player ("inventory") into
+// the thief's bag, each with the given percent chance (ROB in ZIL). It
+// reports whether anything was taken.
+func (g *GameV2) rob(from string, chance int) bool {
+	robbed := false
+	for _, id := range append([]string{}, g.contentsOf(from)...) {
+		item := g.Items[id]
+		if item == nil || item.Flags.IsInvisible || item.Flags.IsSacred || !item.Flags.IsTakeable || item.Value <= 0 {
+			continue
+		}
+		if g.randomChance(chance) {
+			g.moveItem(id, "thief")
+			robbed = true
+		}
+	}
+	return robbed
+}
+
+// stealJunk sometimes pockets a worthless item lying in a room; he always
+// takes back his stiletto (STEAL-JUNK in ZIL)
+func (g *GameV2) stealJunk(roomID string) string {
+	for _, id := range g.contentsOf(roomID) {
+		item := g.Items[id]
+		if item == nil || item.Flags.IsInvisible || item.Flags.IsSacred || !item.Flags.IsTakeable || item.Value > 0 {
+			continue
+		}
+		if item.ID != "stiletto" && !g.randomChance(10) {
+			continue
+		}
+
+		if item.ID == "stiletto" {
+			g.removeItem(id) // Back in his hand
+		} else {
+			g.moveItem(id, "thief")
+		}
+		if item.ID == "rope" {
+			g.Flags["dome-flag"] = false
+		}
+		if roomID == g.Location {
+			return "You suddenly notice that the " + item.Name + " vanished."
+		}
+		return ""
+	}
+	return ""
+}
+
+// dropJunk sometimes leaves worthless items from the bag in a room
+// (DROP-JUNK in ZIL)
+func (g *GameV2) dropJunk(roomID string) string {
+	if !g.randomChance(30) {
+		return ""
+	}
+
+	dropped := false
+	for _, id := range append([]string{}, g.NPCs["thief"].Inventory...) {
+		item := g.Items[id]
+		if item == nil || item.ID == "stiletto" || item.ID == "large-bag" || item.Value > 0 {
+			continue
+		}
+		if g.randomChance(30) {
+			g.moveItem(id, roomID)
+			dropped = true
+		}
+	}
+
+	if dropped && roomID == g.Location {
+		return "The robber, rummaging through his bag, dropped a few items he found valueless."
+	}
+	return ""
+}
+
+// depositBooty leaves the thief's valuables in his lair, opening the egg on
+// the way (DEPOSIT-BOOTY in ZIL)
+func (g *GameV2) depositBooty() {
+	for _, id := range append([]string{}, g.NPCs["thief"].Inventory...) {
+		item := g.Items[id]
+		if item == nil || item.ID == "large-bag" || item.Value <= 0 {
+			continue
+		}
+		g.moveItem(id, thiefLair)
+		item.Flags.IsInvisible = false
+		if item.ID == "egg" {
+			g.solveEgg()
+		}
+	}
+}
+
+// hackTreasures puts the thief's treasures back on display once the player
+// has gone (HACK-TREASURES in ZIL)
+func (g *GameV2) hackTreasures() {
+	g.recoverStiletto()
+	g.NPCs["thief"].Flags.IsInvisible = true
+	for _, id := range g.contentsOf(thiefLair) {
+		g.Items[id].Flags.IsInvisible = false
+	}
+}
+
+// thiefDefendsLair brings the thief running when the player enters the
+// treasure room, and hides his treasures (TREASURE-ROOM-FCN and
+// THIEF-IN-TREASURE in ZIL)
+func (g *GameV2) thiefDefendsLair() string {
+	thief := g.NPCs["thief"]
+	if thief == nil || !thief.Flags.IsAlive || thief.Flags.IsUnconscious || g.Dead {
+		return ""
+	}
+
+	var result strings.Builder
+	if thief.Location != thiefLair {
+		result.WriteString("You hear a scream of anguish as you violate the robber's hideaway. Using passages unknown to you, he rushes to its defense.\n\n")
+		g.moveNPC(thief, thiefLair)
+	}
+	thief.Flags.IsInvisible = false
+	thief.Flags.IsFighting = true
+	g.recoverStiletto()
+
+	hid := false
+	for _, id := range g.contentsOf(thiefLair) {
+		item := g.Items[id]
+		if item.ID != "chalice" && !item.Flags.IsInvisible {
+			item.Flags.IsInvisible = true
+			hid = true
+		}
+	}
+	if hid {
+		result.WriteString("The thief gestures mysteriously, and the treasures in the room suddenly vanish.\n\n")
+	}
+	return result.String()
+}
+
+// recoverStiletto puts the thief's stiletto back in his hand if it is lying
+// where he is (RECOVER-STILETTO in ZIL)
+func (g *GameV2) recoverStiletto() {
+	thief := g.NPCs["thief"]
+	if stiletto := g.Items["stiletto"]; stiletto != nil && stiletto.Location == thief.Location {
+		g.removeItem(stiletto.ID)
+	}
+}
+
+// thiefMayEnter reports whether the thief will go into a room. He keeps to
+// the dungeon and stays out of holy places (SACREDBIT and RLANDBIT in ZIL).
+func (g *GameV2) thiefMayEnter(roomID string) bool {
+	room := g.Rooms[roomID]
+	return room != nil && !room.Flags.IsSacred && !room.Flags.IsOutdoors &&
+		!room.Flags.IsUnderwater && !strings.HasPrefix(roomID, "river")
+}
+
+// moveThiefToNextRoom moves the thief, unseen, to a random neighbouring room
+func (g *GameV2) moveThiefToNextRoom() {
+	thief := g.NPCs["thief"]
+	currentRoom := g.Rooms[thief.Location]
+	if currentRoom == nil {
+		return
+	}
+
+	var possibleRooms []string
+	for _, direction := range sortedExitDirections(currentRoom) {
+		to := currentRoom.Exits[direction].To
+		if g.thiefMayEnter(to) && !containsString(possibleRooms, to) {
+			possibleRooms = append(possibleRooms, to)
+		}
+	}
+
+	if len(possibleRooms) > 0 {
+		g.moveNPC(thief, possibleRooms[g.randomInt(len(possibleRooms))])
+		thief.Flags.IsInvisible = true
+		thief.Flags.IsFighting = false
+	}
+}
+
+// sortedExitDirections returns a room's exit directions in a stable order
+func sortedExitDirections(room *Room) []string {
+	directions := make([]string, 0, len(room.Exits))
+	for direction := range room.Exits {
+		directions = append(directions, direction)
+	}
+	sort.Strings(directions)
+	return directions
+}
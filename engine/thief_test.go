@@ -0,0 +1,158 @@
+package engine
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+// thiefIn moves the thief to a room and returns him
+func thiefIn(g *GameV2, roomID string) *NPC {
+	thief := g.NPCs["thief"]
+	g.moveNPC(thief, roomID)
+	return thief
+}
+
+func TestThiefAvoidsSacredRooms(t *testing.T) {
+	g := NewGameV2("test")
+	g.rand = rand.New(rand.NewSource(1))
+	g.Location = "west-of-house"
+	thief := thiefIn(g, "cellar")
+
+	for i := 0; i < 500; i++ {
+		g.moveThiefToNextRoom()
+		room := g.Rooms[thief.Location]
+		if room.Flags.IsSacred || room.Flags.IsOutdoors {
+			t.Fatalf("move %d: thief wandered into %s", i, thief.Location)
+		}
+	}
+}
+
+func TestThiefRobsVisitedRooms(t *testing.T) {
+	g := NewGameV2("test")
+	g.Location = "west-of-house"
+	g.moveItem("painting", "gallery")
+	g.moveItem("leaflet", "gallery")
+	thiefIn(g, "gallery")
+
+	if g.rob("gallery", 100); g.Items["painting"].Location != "thief" {
+		t.Errorf("painting Location = %q, want it in the thief's bag", g.Items["painting"].Location)
+	}
+	if g.Items["leaflet"].Location != "gallery" {
+		t.Error("rob should only take valuables")
+	}
+
+	// He always takes back his own stiletto; clear out the leaflet so
+	// the junk roll cannot pick it first
+	g.removeItem("leaflet")
+	g.moveItem("stiletto", "gallery")
+	g.stealJunk("gallery")
+	if g.Items["stiletto"].Location != "" {
+		t.Errorf("stiletto Location = %q, want it back in the thief's hand", g.Items["stiletto"].Location)
+	}
+}
+
+func TestThiefLeavesSacredJunk(t *testing.T) {
+	g := NewGameV2("test")
+	g.rand = rand.New(rand.NewSource(1))
+	g.Location = "west-of-house"
+	g.moveItem("leaflet", "gallery")
+	g.Items["leaflet"].Flags.IsSacred = true
+
+	for i := 0; i < 100; i++ {
+		g.stealJunk("gallery")
+	}
+	if g.Items["leaflet"].Location != "gallery" {
+		t.Errorf("leaflet Location = %q, want a sacred item left alone", g.Items["leaflet"].Location)
+	}
+}
+
+func TestThiefOpensEggInLair(t *testing.T) {
+	g := NewGameV2("test")
+	g.Location = "west-of-house"
+	thiefIn(g, thiefLair)
+	g.moveItem("egg", "thief")
+	g.moveItem("leaflet", "thief")
+
+	g.processThiefTurn()
+
+	egg := g.Items["egg"]
+	if egg.Location != thiefLair || !egg.Flags.IsOpen || !g.Flags["egg-solve"] {
+		t.Errorf("egg Location = %q, open = %v; want it opened and left in the lair", egg.Location, egg.Flags.IsOpen)
+	}
+	if g.Items["leaflet"].Location == thiefLair {
+		t.Error("junk should not be deposited in the lair")
+	}
+}
+
+func TestThiefDefendsLair(t *testing.T) {
+	g := NewGameV2("test")
+	g.rand = rand.New(rand.NewSource(1))
+	g.Location = "cyclops-room"
+	g.Flags["cyclops-dead"] = true
+	g.moveItem("lamp", "inventory")
+	g.Items["lamp"].Flags.IsLit = true
+	g.moveItem("painting", thiefLair)
+	thief := thiefIn(g, "maze-1")
+	thief.Flags.IsInvisible = true
+
+	result := g.handleMove("up")
+	if !strings.Contains(result, "rushes to its defense") || !strings.Contains(result, "treasures in the room suddenly vanish") {
+		t.Fatalf("expected the thief to defend his lair, got: %s", result)
+	}
+	if thief.Location != thiefLair || thief.Flags.IsInvisible || !thief.Flags.IsFighting {
+		t.Errorf("thief should be in his lair, visible and fighting")
+	}
+	if !g.Items["painting"].Flags.IsInvisible || g.Items["chalice"].Flags.IsInvisible {
+		t.Error("the painting should be hidden and the chalice left alone")
+	}
+
+	// Killing him brings the treasures back
+	g.applyHeroOutcome(thief, CombatKilled)
+	death := g.handleNPCDeath(thief)
+	if !strings.Contains(death, "his treasures reappear") || g.Items["painting"].Flags.IsInvisible {
+		t.Errorf("the painting should reappear when the thief dies, got: %s", death)
+	}
+}
+
+func TestThiefFleesLosingFight(t *testing.T) {
+	fled := false
+	for seed := int64(1); seed <= 20 && !fled; seed++ {
+		g := NewGameV2("test")
+		g.rand = rand.New(rand.NewSource(seed))
+		g.Score = 350 // Much stronger than the thief
+		g.Location = "maze-1"
+		thief := thiefIn(g, "maze-1")
+		thief.Flags.IsFighting = true
+
+		msg, _ := g.thiefVsAdventurer(true)
+		fled = strings.Contains(msg, "discretion to be the better part of valor")
+		if fled && (!thief.Flags.IsInvisible || thief.Flags.IsFighting) {
+			t.Error("a fleeing thief should vanish and stop fighting")
+		}
+	}
+	if !fled {
+		t.Error("a badly outmatched thief should flee")
+	}
+}
+
+func TestThiefStateSurvivesSave(t *testing.T) {
+	g := NewGameV2("test")
+	thief := thiefIn(g, thiefLair)
+	thief.Flags.IsInvisible = true
+	thief.Flags.IsFighting = true
+	g.moveItem("painting", thiefLair)
+	g.Items["painting"].Flags.IsInvisible = true
+	g.moveItem("leaflet", "thief")
+
+	g2 := NewGameV2("test")
+	g2.deserializeState(g.serializeState())
+
+	thief2 := g2.NPCs["thief"]
+	if thief2.Location != thiefLair || !thief2.Flags.IsInvisible || !thief2.Flags.IsFighting {
+		t.Errorf("thief state not restored: %+v", thief2.Flags)
+	}
+	if !g2.Items["painting"].Flags.IsInvisible || !thief2.HasItem("leaflet") {
+		t.Error("hidden treasure and the thief's bag should be restored")
+	}
+}
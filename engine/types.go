@@ -19,6 +19,8 @@ type RoomFlags struct {
 	IsDark      bool // Room is inherently dark
 	IsUnderwater bool
 	IsOutdoors  bool
+	IsSacred    bool // The thief never enters (SACREDBIT in ZIL)
+	IsWater     bool // Can only be reached by boat (NONLANDBIT in ZIL)
 }
 
 // RoomActionHandler handles room-specific events
@@ -30,6 +32,7 @@ const (
 	EventEnter RoomEvent = iota
 	EventLook
 	EventLeave
+	EventEnd // After every turn the player spends in the room (M-END in ZIL)
 )
 
 // Exit represents a connection between rooms
@@ -49,13 +52,22 @@ type Item struct {
 	Text            string   // Text content for readable items (books, scrolls, etc)
 	Location        string   // Room ID or "inventory" or container ID
 	Flags           ItemFlags
-	Weight          int
+	Weight          int  // SIZE in ZIL
+	Capacity        int  // CAPACITY in ZIL: total weight a container holds (0 = no limit)
 	Value           int  // For treasures (score)
 	Fuel            int  // For light sources (turns remaining, -1 = infinite)
 	GlowLevel       int  // For sword: 0=not glowing, 1=faint, 2=bright
+	Vehicle         *Vehicle // Set for items that carry things between rooms
 	Action          ItemActionHandler
 }
 
+// Vehicle makes an item carry its contents, and perhaps the player, from
+// room to room as a unit (VEHBIT in ZIL). The item must also be a container.
+type Vehicle struct {
+	Boardable bool // The player can get in it
+	Floats    bool // It goes on water but can't be dragged overland with the player in it
+}
+
 // ItemFlags holds boolean flags for items
 type ItemFlags struct {
 	IsTakeable    bool
@@ -73,6 +85,9 @@ type ItemFlags struct {
 	IsInvisible   bool // For items that are initially invisible (like pot-of-gold)
 	NoRoomListing bool // NDESCBIT in ZIL - don't list in room desc (already mentioned in room text)
 	IsBurnable    bool // BURNBIT in ZIL - can be burned
+	IsSacred      bool // SACREDBIT in ZIL - the thief leaves it alone
+	IsFlame       bool // FLAMEBIT in ZIL - a naked flame when lit, unlike the lamp
+	IsTool        bool // TOOLBIT in ZIL - good for prying things open
 }
 
 // ItemActionHandler handles item-specific interactions
@@ -89,20 +104,26 @@ type NPC struct {
 	Weapon      string   // Item ID of weapon
 	Inventory   []string // Item IDs
 	Hostile     bool
+	WakeChance  int // Percent chance of coming round next turn (V-PROB in ZIL)
 	Action      NPCActionHandler
 }
 
 // NPCFlags holds boolean flags for NPCs
 type NPCFlags struct {
-	IsAggressive bool
-	IsFriendly   bool
-	IsAlive      bool
-	CanTalk      bool
-	CanFight     bool
+	IsAggressive  bool
+	IsFriendly    bool
+	IsAlive       bool
+	CanTalk       bool
+	CanFight      bool
+	IsUnconscious bool // Knocked out in combat (negative STRENGTH in ZIL)
+	IsStaggered   bool // Skips its next attack (STAGGERED in ZIL)
+	IsFighting    bool // Attacks the player every turn (FIGHTBIT in ZIL)
+	IsInvisible   bool // In the room but unseen (INVISIBLE in ZIL)
 }
 
-// NPCActionHandler handles NPC behavior
-type NPCActionHandler func(g *GameV2, npc *NPC, action string) string
+// NPCActionHandler handles commands addressed to an NPC ("troll, ...").
+// Returning "" falls back to the default refusal (actor functions in ZIL).
+type NPCActionHandler func(g *GameV2, npc *NPC, cmd *Command) string
 
 // NewRoom creates a new room with default values
 func NewRoom(id, name, description string) *Room {
@@ -126,6 +147,7 @@ func NewItem(id, name, description string) *Item {
 		Aliases:     []string{},
 		Description: description,
 		Flags:       ItemFlags{},
+		Weight:      5, // Default SIZE in ZIL
 	}
 }
 
@@ -182,6 +204,15 @@ func (r *Room) RemoveNPC(npcID string) {
 	}
 }
 
+func (r *Room) HasItem(itemID string) bool {
+	for _, id := range r.Contents {
+		if id == itemID {
+			return true
+		}
+	}
+	return false
+}
+
 func (r *Room) HasNPC(npcID string) bool {
 	for _, id := range r.NPCs {
 		if id == npcID {
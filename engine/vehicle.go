@@ -0,0 +1,133 @@
+package engine
+
+// Vehicles (VEHBIT, V-BOARD, V-DISEMBARK and the vehicle checks in GOTO in
+// ZIL)
+//
+// A vehicle is a container that moves from room to room as a unit, taking
+// whatever is inside along. The player can sit in some, like the magic boat,
+// and is then carried with it; others, like the shaft basket, only carry
+// objects. A vehicle that floats can go out on the water, but not overland
+// with the player sitting in it.
+
+// isVehicle reports whether an item is a vehicle the player can get in
+func isVehicle(item *Item) bool {
+	return item != nil && item.Vehicle != nil && item.Vehicle.Boardable
+}
+
+// vehicle returns the vehicle the player is sitting in, or nil on foot
+func (g *GameV2) vehicle() *Item {
+	if g.Player.Vehicle == "" {
+		return nil
+	}
+	return g.Items[g.Player.Vehicle]
+}
+
+// moveVehicle takes a vehicle and everything in it to another room,
+// including the player if they are aboard
+func (g *GameV2) moveVehicle(vehicleID, to string) {
+	g.moveItem(vehicleID, to)
+	if g.Player.Vehicle == vehicleID {
+		g.Location = to
+	}
+}
+
+// vehicleBlocks stops the player reaching water without something that
+// floats, or dragging a boat overland while sitting in it (GOTO in ZIL). It
+// returns "" if the move is allowed.
+func (g *GameV2) vehicleBlocks(to string) string {
+	water := g.onWater(to)
+	v := g.vehicle()
+	switch {
+	case water && (v == nil || !v.Vehicle.Floats):
+		return "You can't go there without a vehicle."
+	case !water && v != nil && v.Vehicle.Floats && !g.onWater(g.Location):
+		return "You can't go there in a " + v.Name + "."
+	}
+	return ""
+}
+
+// rideVehicle takes the player's vehicle along when they move, and says so
+// when a boat reaches dry land (GOTO in ZIL)
+func (g *GameV2) rideVehicle(to string) string {
+	v := g.vehicle()
+	if v == nil {
+		return ""
+	}
+	landing := v.Vehicle.Floats && g.onWater(g.Location) && !g.onWater(to)
+	g.moveVehicle(v.ID, to)
+	if landing {
+		return "The " + v.Name + " comes to a rest on the shore.\n\n"
+	}
+	return ""
+}
+
+// vehicleDescription tells the player what they are sitting in, for the
+// room description (DESCRIBE-ROOM in ZIL)
+func (g *GameV2) vehicleDescription() string {
+	if v := g.vehicle(); v != nil {
+		return "You are in the " + v.Name + "."
+	}
+	return ""
+}
+
+// setBasketLowered records which end of its chain the shaft basket hangs
+// from; it is described differently at each (RAISED-BASKET and
+// LOWERED-BASKET in ZIL)
+func (g *GameV2) setBasketLowered(lowered bool) {
+	g.Flags["basket-lowered"] = lowered
+	if lowered {
+		g.Items["basket"].RoomDescription = "From the chain is suspended a basket."
+	} else {
+		g.Items["basket"].RoomDescription = "At the end of the chain is a basket."
+	}
+}
+
+// handleBoard gets the player into a vehicle (V-BOARD in ZIL)
+func (g *GameV2) handleBoard(objName string) string {
+	if objName == "" {
+		return "Board what?"
+	}
+
+	item := g.findItem(objName)
+	if item == nil {
+		return "You can't see any " + objName + " here."
+	}
+
+	if !isVehicle(item) {
+		return "You have a theory on how to board a " + item.Name + ", perhaps?"
+	}
+	if item.Location != g.Location {
+		return "The " + item.Name + " must be on the ground to be boarded."
+	}
+	if g.Player.Vehicle != "" {
+		return "You are already in the " + item.Name + ", cretin!"
+	}
+
+	// Thin plastic (RBOAT-FUNCTION in ZIL)
+	if isBoat(item) && g.carryingSharpObject() {
+		return g.punctureBoat()
+	}
+
+	g.Player.Vehicle = item.ID
+	return "You are now in the " + item.Name + "."
+}
+
+// handleDisembark gets the player out of their vehicle (V-DISEMBARK in ZIL)
+func (g *GameV2) handleDisembark(objName string) string {
+	v := g.vehicle()
+	if v == nil {
+		if objName == "" {
+			return g.handleMove("out")
+		}
+		return "You're not in that!"
+	}
+	if objName != "" && g.findItem(objName) != v {
+		return "You're not in that!"
+	}
+	if v.Vehicle.Floats && g.onWater(g.Location) {
+		return "You realize that getting out here would be fatal."
+	}
+
+	g.Player.Vehicle = ""
+	return "You are on your own feet again."
+}
@@ -0,0 +1,87 @@
+package engine
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLookSaysWhichVehicle(t *testing.T) {
+	g := boatAtDamBase(t)
+
+	result := g.Process("look")
+	if !strings.Contains(result, "Dam Base") || !strings.Contains(result, "You are in the magic boat.") {
+		t.Errorf("look in the boat: %s", result)
+	}
+	if strings.Contains(result, "There is a magic boat here.") {
+		t.Errorf("look in the boat should not list the boat: %s", result)
+	}
+	if result := g.Process("disembark"); !strings.Contains(result, "on your own feet") {
+		t.Errorf("disembark: %s", result)
+	}
+	if result := g.Process("look"); strings.Contains(result, "You are in the") {
+		t.Errorf("look on foot: %s", result)
+	}
+}
+
+func TestBasketCarriesItsContents(t *testing.T) {
+	g := NewGameV2("test")
+	g.moveItem("coal", "basket")
+
+	g.moveItem("lamp", "inventory")
+	g.Items["lamp"].Flags.IsLit = true
+
+	g.Location = "shaft-room"
+	if result := g.Process("look"); !strings.Contains(result, "At the end of the chain is a basket.") {
+		t.Errorf("look with the basket raised: %s", result)
+	}
+	if result := g.Process("board basket"); !strings.Contains(result, "theory on how to board a basket") {
+		t.Errorf("board basket: %s", result)
+	}
+
+	g.Location = "machine-room"
+	g.Process("push lower button")
+	if g.parentOf("basket") != "lower-shaft" || g.parentOf("coal") != "basket" {
+		t.Errorf("basket in %q with coal in %q, want the coal carried down in the basket",
+			g.parentOf("basket"), g.parentOf("coal"))
+	}
+
+	g.Location = "lower-shaft"
+	if result := g.Process("look"); !strings.Contains(result, "From the chain is suspended a basket.") {
+		t.Errorf("look with the basket lowered: %s", result)
+	}
+
+	restored := NewGameV2("test")
+	restored.deserializeState(g.serializeState())
+	if result := restored.Process("look"); !strings.Contains(result, "From the chain is suspended a basket.") {
+		t.Errorf("look with the basket lowered after a restore: %s", result)
+	}
+}
+
+func TestBoatChangesInPlace(t *testing.T) {
+	g := boatAtDamBase(t)
+	g.Process("disembark")
+
+	if result := g.Process("deflate boat"); !strings.Contains(result, "The boat deflates.") {
+		t.Fatalf("deflate boat: %s", result)
+	}
+	if g.BoatState != boatDeflated || isVehicle(g.Items["boat"]) || g.parentOf("boat-label") != "boat" {
+		t.Errorf("deflated boat is %s, vehicle %v, label in %q; want a flat boat with the label folded inside",
+			g.BoatState, isVehicle(g.Items["boat"]), g.parentOf("boat-label"))
+	}
+
+	g.Process("inflate boat with pump")
+	g.setBoatState(boatPunctured)
+	if result := g.Process("inflate boat with pump"); !strings.Contains(result, "punctured") {
+		t.Errorf("inflate punctured boat: %s", result)
+	}
+	g.moveItem("putty", "inventory")
+	if result := g.Process("plug boat with putty"); !strings.Contains(result, "The boat is repaired.") {
+		t.Fatalf("plug boat: %s", result)
+	}
+
+	restored := NewGameV2("test")
+	restored.deserializeState(g.serializeState())
+	if restored.BoatState != boatDeflated || restored.Items["boat"].Name != "inflatable boat" {
+		t.Errorf("restored boat is %s (%q), want it deflated", restored.BoatState, restored.Items["boat"].Name)
+	}
+}
@@ -225,6 +225,8 @@ func (v *Vocabulary) initObjects() {
 	v.addObject("kitchen-window", "window", "kitchen window", "kitchen-window")
 	v.addObject("door", "door", "front-door", "entrance")
 	v.addObject("board", "board", "boards", "planks")
+	v.addObject("barrow", "barrow", "barrow-door")
+	v.addObject("map", "map", "parchment")
 
 	// Trophy case and living room
 	v.addObject("trophy-case", "case", "trophy", "display", "trophy-case")
@@ -261,7 +263,7 @@ func (v *Vocabulary) initObjects() {
 	v.addObject("knife", "knife", "rusty-knife", "blade")
 	v.addObject("axe", "axe", "ax")
 	v.addObject("rope", "rope", "line", "cord")
-	v.addObject("match", "match", "matches")
+	v.addObject("match", "match", "matches", "matchbook", "book-of-matches")
 	v.addObject("candle", "candle", "candles")
 	v.addObject("torch", "torch")
 	v.addObject("wrench", "wrench", "spanner")
@@ -282,7 +284,11 @@ func (v *Vocabulary) initObjects() {
 	v.addObject("river", "river", "stream")
 
 	// Dam/reservoir area
-	v.addObject("dam", "dam")
+	v.addObject("dam", "dam", "gate", "gates", "sluice")
+	v.addObject("control-panel", "panel", "controls")
+	v.addObject("leak", "leak", "drip", "pipe")
+	v.addObject("putty", "putty", "material", "gunk")
+	v.addObject("tube", "tube", "toothpaste")
 	v.addObject("reservoir", "reservoir", "lake")
 	v.addObject("bolt", "bolt")
 	v.addObject("bubble", "bubble")
@@ -291,17 +297,22 @@ func (v *Vocabulary) initObjects() {
 	// More items from 1dungeon.zil
 	v.addObject("book", "book", "guidebook", "guide", "prayer-book", "black-book", "prayer", "black")
 	v.addObject("bell", "bell")
-	v.addObject("canary", "canary", "bird")
+	v.addObject("canary", "canary", "bird", "clockwork")
+	v.addObject("bauble", "bauble")
+	v.addObject("songbird", "songbird")
 	v.addObject("garlic", "garlic", "clove")
 	v.addObject("coffin", "coffin", "casket")
 	v.addObject("basket", "basket")
 	v.addObject("boat", "boat", "raft")
+	v.addObject("pump", "pump")
+	v.addObject("label", "label")
 	v.addObject("lunch", "lunch", "sandwich", "food")
 	v.addObject("troll", "troll", "nasty", "monster")
 	v.addObject("thief", "thief", "robber", "bandit")
 	v.addObject("cyclops", "cyclops", "giant")
 	v.addObject("bat", "bat", "vampire-bat")
 	v.addObject("ghosts", "ghosts", "spirits", "ghost", "spirit")
+	v.addObject("hands", "hands", "hand", "fist", "fists") // HANDS in gglobals.zil
 	v.addObject("rope", "rope")
 	v.addObject("bell", "bell")
 	v.addObject("tree", "tree", "trees")
@@ -315,7 +326,6 @@ func (v *Vocabulary) initObjects() {
 	v.addObject("trunk-of-jewels", "trunk-of-jewels", "jewels")
 	v.addObject("pearl", "pearl")
 	v.addObject("clam", "clam", "shell")
-	v.addObject("matches", "matches", "matchbook", "book-of-matches")
 	v.addObject("mirror", "mirror", "looking-glass")
 	v.addObject("pile-of-leaves", "pile", "pile-of-leaves")
 	v.addObject("grating", "grating")
@@ -326,6 +336,7 @@ func (v *Vocabulary) initObjects() {
 	v.addObject("glacier", "glacier", "ice")
 	v.addObject("slide", "slide")
 	v.addObject("brick", "brick")
+	v.addObject("fuse", "fuse", "wire")
 	v.addObject("statue", "statue", "idol")
 	v.addObject("air-pump", "air-pump")
 	v.addObject("cyclops-treasure", "cyclops-treasure", "treasure-chest")
@@ -401,6 +412,7 @@ func (v *Vocabulary) initDirections() {
 	v.addDirection("down", "down", "d")
 	v.addDirection("in", "in")
 	v.addDirection("out", "out")
+	v.addDirection("land", "land")
 }
 
 // Helper methods to add words with multiple synonyms
@@ -0,0 +1,63 @@
+package engine
+
+// Load limits (WEIGHT, LOAD-MAX and LOAD-ALLOWED in ZIL)
+
+// weightOf returns an item's weight including everything inside it, however
+// deeply nested (WEIGHT in ZIL)
+func (g *GameV2) weightOf(itemID string) int {
+	item := g.Items[itemID]
+	if item == nil {
+		return 0
+	}
+	weight := item.Weight
+	for _, id := range g.contentsOf(item.ID) {
+		weight += g.weightOf(id)
+	}
+	return weight
+}
+
+// carriedWeight returns the total weight of the player's inventory. Worn
+// items count for almost nothing.
+func (g *GameV2) carriedWeight() int {
+	weight := 0
+	for _, id := range g.Player.Inventory {
+		if item := g.Items[id]; item != nil && item.Flags.IsWearable {
+			weight++
+			continue
+		}
+		weight += g.weightOf(id)
+	}
+	return weight
+}
+
+// loadAllowed returns how much the player can carry right now. Each point of
+// wound damage takes 10 off the limit until it heals (LOAD-ALLOWED in ZIL).
+func (g *GameV2) loadAllowed() int {
+	load := g.Player.MaxWeight
+	if g.Player.StrengthModifier < 0 {
+		load += 10 * g.Player.StrengthModifier
+	}
+	if load < 0 {
+		load = 0
+	}
+	return load
+}
+
+// tooHeavyMessage explains why an item can't be picked up, mentioning the
+// player's wounds if those are what is holding them back
+func (g *GameV2) tooHeavyMessage() string {
+	if g.loadAllowed() < g.Player.MaxWeight {
+		return "Your load is too heavy, especially in light of your condition."
+	}
+	return "Your load is too heavy."
+}
+
+// containerHasRoom reports whether an item fits in a container's remaining
+// capacity (V-PUT in ZIL)
+func (g *GameV2) containerHasRoom(container *Item, itemID string) bool {
+	if container.Capacity == 0 {
+		return true
+	}
+	used := g.weightOf(container.ID) - container.Weight
+	return used+g.weightOf(itemID) <= container.Capacity
+}
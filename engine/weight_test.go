@@ -0,0 +1,77 @@
+package engine
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestWeightIncludesContents(t *testing.T) {
+	g := NewGameV2("test")
+	g.moveItem("garlic", "sandwich-bag")
+
+	// Bag 9 + lunch 5 + garlic 4
+	if got := g.weightOf("sandwich-bag"); got != 18 {
+		t.Errorf("weightOf(sandwich-bag) = %d, want 18", got)
+	}
+}
+
+func TestTakeRefusesWhenOverloaded(t *testing.T) {
+	g := NewGameV2("test")
+	g.Location = "living-room"
+	for _, id := range []string{"timbers", "coffin"} {
+		g.moveItem(id, "inventory")
+	}
+	// 50 + 55 is already over the limit; the lamp won't fit
+	result := g.Process("take lamp")
+	if result != "Your load is too heavy." {
+		t.Errorf("take lamp = %q, want load refusal", result)
+	}
+	if g.Items["lamp"].Location == "inventory" {
+		t.Error("lamp was taken despite the load limit")
+	}
+}
+
+func TestWoundsLowerLoadLimit(t *testing.T) {
+	g := NewGameV2("test")
+	g.Location = "living-room"
+	g.moveItem("coffin", "inventory")
+	g.moveItem("rope", "inventory")
+	g.Player.StrengthModifier = -2
+
+	// 55 + 10 + 30 for the sword is over 80
+	result := g.Process("take sword")
+	if !strings.Contains(result, "especially in light of your condition") {
+		t.Errorf("take sword while wounded = %q, want mention of condition", result)
+	}
+
+	g.Player.StrengthModifier = 0
+	if result := g.Process("take sword"); result != "Taken." {
+		t.Errorf("take sword when healthy = %q, want Taken.", result)
+	}
+}
+
+func TestTakingFromCarriedContainerIsFree(t *testing.T) {
+	g := NewGameV2("test")
+	g.moveItem("sandwich-bag", "inventory")
+	g.moveItem("timbers", "inventory")
+	g.moveItem("coffin", "inventory")
+	g.Items["sandwich-bag"].Flags.IsOpen = true
+
+	if result := g.Process("take lunch"); result != "Taken." {
+		t.Errorf("take lunch from carried bag = %q, want Taken.", result)
+	}
+}
+
+func TestPutRespectsCapacity(t *testing.T) {
+	g := NewGameV2("test")
+	g.moveItem("bottle", "inventory")
+	g.moveItem("lamp", "inventory")
+	g.Items["bottle"].Flags.IsOpen = true
+
+	if result := g.Process("put lamp in bottle"); result != "There's no room." {
+		t.Errorf("put lamp in bottle = %q, want There's no room.", result)
+	}
+	if result := g.Process("put lamp in trophy case"); result == "There's no room." {
+		t.Error("trophy case should have room for the lamp")
+	}
+}
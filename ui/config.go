@@ -0,0 +1,84 @@
+package ui
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// Config holds the display settings read from config.json in the gork
+// config directory. Command-line flags override it.
+type Config struct {
+	Theme        string `json:"theme"`         // See SetTheme
+	TypingEffect bool   `json:"typing_effect"` // Print text character by character
+	TypingSpeed  int    `json:"typing_speed"`  // Delay between characters, in milliseconds
+	ShowTitle    bool   `json:"show_title"`    // Show the title screen on start-up
+}
+
+// DefaultConfig returns the settings used when there is no config file
+func DefaultConfig() Config {
+	return Config{
+		Theme:        "amber",
+		TypingEffect: EnableTypingEffect,
+		TypingSpeed:  TypingSpeed,
+		ShowTitle:    ShowTitle,
+	}
+}
+
+// ConfigPath returns where the config file lives, next to the saves
+func ConfigPath() (string, error) {
+	configDir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get config directory: %w", err)
+	}
+	return filepath.Join(configDir, "gork", "config.json"), nil
+}
+
+// LoadConfig reads the config file. Settings missing from the file, or a
+// missing file, keep their defaults.
+func LoadConfig() (Config, error) {
+	cfg := DefaultConfig()
+
+	path, err := ConfigPath()
+	if err != nil {
+		return cfg, err
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return cfg, nil
+	}
+	if err != nil {
+		return cfg, fmt.Errorf("failed to read config file: %w", err)
+	}
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return DefaultConfig(), fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	return cfg, nil
+}
+
+// RetroTypingSpeed is the typing delay in retro mode, slightly slower for
+// that 1980s modem feel
+const RetroTypingSpeed = 30
+
+// Retro switches on the retro typing in the settings. Flags read after it
+// can still change the typing.
+func (c *Config) Retro() {
+	c.TypingEffect = true
+	c.TypingSpeed = RetroTypingSpeed
+}
+
+// Apply puts the settings into effect
+func (c Config) Apply() error {
+	if err := SetTheme(c.Theme); err != nil {
+		return err
+	}
+	EnableTypingEffect = c.TypingEffect
+	ShowTitle = c.ShowTitle
+	if c.TypingSpeed > 0 {
+		TypingSpeed = c.TypingSpeed
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+package ui
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// useTempConfigDir points the config directory at a fresh temporary one and
+// returns where the config file goes
+func useTempConfigDir(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", dir)
+
+	path, err := ConfigPath()
+	if err != nil {
+		t.Fatalf("ConfigPath() error = %v", err)
+	}
+	if !strings.HasPrefix(path, dir) {
+		t.Skip("the config directory does not follow XDG_CONFIG_HOME on this platform")
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadConfigWithoutFile(t *testing.T) {
+	useTempConfigDir(t)
+
+	cfg, err := LoadConfig()
+	if err != nil {
+		t.Fatalf("LoadConfig() error = %v", err)
+	}
+	if cfg != DefaultConfig() {
+		t.Errorf("LoadConfig() = %+v, want the defaults %+v", cfg, DefaultConfig())
+	}
+}
+
+func TestLoadConfigKeepsMissingDefaults(t *testing.T) {
+	path := useTempConfigDir(t)
+	os.WriteFile(path, []byte(`{"theme": "green", "typing_speed": 50}`), 0644)
+
+	cfg, err := LoadConfig()
+	if err != nil {
+		t.Fatalf("LoadConfig() error = %v", err)
+	}
+	want := DefaultConfig()
+	want.Theme = "green"
+	want.TypingSpeed = 50
+	if cfg != want {
+		t.Errorf("LoadConfig() = %+v, want %+v", cfg, want)
+	}
+}
+
+func TestLoadConfigRejectsBadJSON(t *testing.T) {
+	path := useTempConfigDir(t)
+	os.WriteFile(path, []byte(`{"theme": `), 0644)
+
+	cfg, err := LoadConfig()
+	if err == nil {
+		t.Fatal("LoadConfig() expected error for a broken file, got nil")
+	}
+	if cfg != DefaultConfig() {
+		t.Errorf("LoadConfig() = %+v after an error, want the defaults", cfg)
+	}
+}
+
+func TestRetroOnlyChangesTyping(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.ShowTitle = false
+	cfg.Retro()
+
+	want := DefaultConfig()
+	want.ShowTitle = false
+	want.TypingEffect = true
+	want.TypingSpeed = RetroTypingSpeed
+	if cfg != want {
+		t.Errorf("Retro() = %+v, want %+v", cfg, want)
+	}
+}
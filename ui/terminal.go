@@ -14,21 +14,26 @@ const (
 	ColorAmber = "\033[38;5;214m"
 	// Classic green monitor color
 	ColorGreen = "\033[38;5;46m"
+	// Paper-white monitor color
+	ColorWhite = "\033[38;5;255m"
 	// White/gray for normal text
 	ColorDefault = "\033[0m"
 	ColorBold    = "\033[1m"
 	ColorDim     = "\033[2m"
-
-	// Current theme (change to ColorGreen for green CRT feel)
-	ThemeColor = ColorAmber
 )
 
 var (
+	// ThemeColor is the current theme (see SetTheme in theme.go)
+	ThemeColor = ColorAmber
+
 	// EnableTypingEffect controls whether text appears character-by-character
 	EnableTypingEffect = false // Set to true for classic typing effect
 
 	// TypingSpeed is the delay between characters (in milliseconds)
 	TypingSpeed = 20
+
+	// ShowTitle controls whether the title screen is shown
+	ShowTitle = true
 )
 
 // PrintTitle displays the game title with ASCII art
@@ -96,7 +101,9 @@ func ClearScreen() {
 // This is used by the "clear" command to refresh the display
 func PrintTitleAndLocation(locationDescription string) {
 	ClearScreen()
-	PrintTitle()
+	if ShowTitle {
+		PrintTitle()
+	}
 	if locationDescription != "" {
 		PrintSlow(locationDescription)
 		fmt.Println()
@@ -140,12 +147,6 @@ func PrintBox(text string) {
 	fmt.Println("┘" + ColorDefault)
 }
 
-// EnableRetroMode turns on all retro features
-func EnableRetroMode() {
-	EnableTypingEffect = true
-	TypingSpeed = 30 // Slightly slower for that 1980s modem feel
-}
-
 // PrintDeath displays a dramatic death message
 func PrintDeath(message string) {
 	fmt.Println()
@@ -0,0 +1,57 @@
+package ui
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Themes are the named CRT colors
+var Themes = map[string]string{
+	"amber": ColorAmber,
+	"green": ColorGreen,
+	"white": ColorWhite,
+}
+
+// ThemeName is the name of the current theme, as given to SetTheme
+var ThemeName = "amber"
+
+// SetTheme changes the text color. The theme is one of the named Themes, a
+// 256-color palette number such as "208", or a truecolor hex value such as
+// "#ffb000".
+func SetTheme(theme string) error {
+	name := strings.ToLower(strings.TrimSpace(theme))
+
+	color, ok := Themes[name]
+	switch {
+	case ok:
+	case strings.HasPrefix(name, "#"):
+		rgb, err := strconv.ParseUint(name[1:], 16, 32)
+		if err != nil || len(name) != 7 {
+			return fmt.Errorf("%q is not a #rrggbb color", theme)
+		}
+		color = fmt.Sprintf("\033[38;2;%d;%d;%dm", rgb>>16, rgb>>8&0xff, rgb&0xff)
+	default:
+		n, err := strconv.Atoi(name)
+		if err != nil || n < 0 || n > 255 {
+			return fmt.Errorf("unknown theme %q (try amber, green, white, 0-255 or #rrggbb)", theme)
+		}
+		color = fmt.Sprintf("\033[38;5;%dm", n)
+	}
+
+	ThemeColor = color
+	ThemeName = name
+	return nil
+}
+
+// ThemeCommand handles the in-game THEME command, which shows or switches
+// the theme while playing
+func ThemeCommand(arg string) string {
+	if strings.TrimSpace(arg) == "" {
+		return "The current theme is " + ThemeName + ". Try THEME AMBER, GREEN, WHITE, a color number from 0 to 255, or #RRGGBB."
+	}
+	if err := SetTheme(arg); err != nil {
+		return "Sorry, " + err.Error() + "."
+	}
+	return "Theme set to " + ThemeName + "."
+}
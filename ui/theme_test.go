@@ -0,0 +1,57 @@
+package ui
+
+import "testing"
+
+func TestSetTheme(t *testing.T) {
+	t.Cleanup(func() { ThemeColor, ThemeName = ColorAmber, "amber" })
+
+	tests := []struct {
+		theme   string
+		color   string
+		name    string
+		wantErr bool
+	}{
+		{theme: "amber", color: ColorAmber, name: "amber"},
+		{theme: " GREEN ", color: ColorGreen, name: "green"},
+		{theme: "white", color: ColorWhite, name: "white"},
+		{theme: "0", color: "\033[38;5;0m", name: "0"},
+		{theme: "208", color: "\033[38;5;208m", name: "208"},
+		{theme: "255", color: "\033[38;5;255m", name: "255"},
+		{theme: "#FFB000", color: "\033[38;2;255;176;0m", name: "#ffb000"},
+		{theme: "#000000", color: "\033[38;2;0;0;0m", name: "#000000"},
+		{theme: "", wantErr: true},
+		{theme: "purple", wantErr: true},
+		{theme: "256", wantErr: true},
+		{theme: "-1", wantErr: true},
+		{theme: "#ffb00", wantErr: true},   // Too short
+		{theme: "#ffb0000", wantErr: true}, // Too long
+		{theme: "#fff", wantErr: true},
+		{theme: "#gggggg", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.theme, func(t *testing.T) {
+			ThemeColor, ThemeName = ColorAmber, "amber"
+
+			err := SetTheme(tt.theme)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("SetTheme(%q) expected error, got nil", tt.theme)
+				}
+				if ThemeColor != ColorAmber || ThemeName != "amber" {
+					t.Errorf("SetTheme(%q) failed but changed the theme to %q", tt.theme, ThemeName)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("SetTheme(%q) error = %v", tt.theme, err)
+			}
+			if ThemeColor != tt.color {
+				t.Errorf("ThemeColor = %q, want %q", ThemeColor, tt.color)
+			}
+			if ThemeName != tt.name {
+				t.Errorf("ThemeName = %q, want %q", ThemeName, tt.name)
+			}
+		})
+	}
+}